@@ -7,9 +7,14 @@ import (
 )
 
 type blueskyConfig struct {
-	AppPassword *string `hcl:"app_password"`
-	Handle      *string `hcl:"handle"` // User handle (e.g., user.bsky.social)
-	PdsHost     *string `hcl:"pds_host"`
+	AppPassword             *string  `hcl:"app_password"`
+	Handle                  *string  `hcl:"handle"` // User handle (e.g., user.bsky.social)
+	PdsHost                 *string  `hcl:"pds_host"`
+	AcceptLabelers          []string `hcl:"accept_labelers,optional"`  // Labeler DIDs sent in the atproto-accept-labelers header
+	RepoCacheDir            *string  `hcl:"repo_cache_dir"`            // Directory repos fetched with sync.getRepo are cached in
+	RelayHost               *string  `hcl:"relay_host"`                // Relay the firehose is read from
+	JetstreamHost           *string  `hcl:"jetstream_host"`            // Jetstream instance events are read from
+	JetstreamZstdDictionary *string  `hcl:"jetstream_zstd_dictionary"` // Path of the zstd dictionary compressed Jetstream events use
 }
 
 func ConfigInstance() interface{} {
@@ -41,5 +46,17 @@ func GetConfig(connection *plugin.Connection) (blueskyConfig, error) {
 		config.PdsHost = &defaultHost
 	}
 
+	// Set default relay host if not specified
+	if config.RelayHost == nil {
+		defaultRelay := "wss://bsky.network"
+		config.RelayHost = &defaultRelay
+	}
+
+	// Set default Jetstream host if not specified
+	if config.JetstreamHost == nil {
+		defaultJetstream := "wss://jetstream2.us-east.bsky.network"
+		config.JetstreamHost = &defaultJetstream
+	}
+
 	return config, nil
 }
@@ -22,13 +22,48 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		},
 		DefaultTransform: transform.FromGo().NullIfZero(),
 		TableMap: map[string]*plugin.Table{
-			"bluesky_post":           tableBlueskyPost(ctx),
-			"bluesky_search_recent":  tableBlueskySearchRecent(ctx),
-			"bluesky_user":           tableBlueskyUser(ctx),
-			"bluesky_user_follower":  tableBlueskyUserFollower(ctx),
-			"bluesky_user_following": tableBlueskyUserFollowing(ctx),
-			"bluesky_user_mention":   tableBlueskyUserMention(ctx),
-			"bluesky_user_post":      tableBlueskyUserPost(ctx),
+			"bluesky_car_block":                 tableBlueskyCarBlock(ctx),
+			"bluesky_car_follow":                tableBlueskyCarFollow(ctx),
+			"bluesky_car_like":                  tableBlueskyCarLike(ctx),
+			"bluesky_car_post":                  tableBlueskyCarPost(ctx),
+			"bluesky_car_record":                tableBlueskyCarRecord(ctx),
+			"bluesky_chat_convo":                tableBlueskyChatConvo(ctx),
+			"bluesky_chat_message":              tableBlueskyChatMessage(ctx),
+			"bluesky_feed":                      tableBlueskyFeed(ctx),
+			"bluesky_feed_generator":            tableBlueskyFeedGenerator(ctx),
+			"bluesky_firehose":                  tableBlueskyFirehose(ctx),
+			"bluesky_jetstream":                 tableBlueskyJetstream(ctx),
+			"bluesky_known_follower":            tableBlueskyKnownFollower(ctx),
+			"bluesky_label":                     tableBlueskyLabel(ctx),
+			"bluesky_labeler":                   tableBlueskyLabeler(ctx),
+			"bluesky_list":                      tableBlueskyList(ctx),
+			"bluesky_list_feed":                 tableBlueskyListFeed(ctx),
+			"bluesky_list_member":               tableBlueskyListMember(ctx),
+			"bluesky_my_block":                  tableBlueskyMyBlock(ctx),
+			"bluesky_my_list_subscription":      tableBlueskyMyListSubscription(ctx),
+			"bluesky_my_mute":                   tableBlueskyMyMute(ctx),
+			"bluesky_my_suggested_follow":       tableBlueskyMySuggestedFollow(ctx),
+			"bluesky_notification":              tableBlueskyNotification(ctx),
+			"bluesky_notification_unread_count": tableBlueskyNotificationUnreadCount(ctx),
+			"bluesky_post":                      tableBlueskyPost(ctx),
+			"bluesky_post_facet":                tableBlueskyPostFacet(ctx),
+			"bluesky_post_media":                tableBlueskyPostMedia(ctx),
+			"bluesky_preference":                tableBlueskyPreference(ctx),
+			"bluesky_relationship":              tableBlueskyRelationship(ctx),
+			"bluesky_repo_blob":                 tableBlueskyRepoBlob(ctx),
+			"bluesky_repo_collection":           tableBlueskyRepoCollection(ctx),
+			"bluesky_repo_record":               tableBlueskyRepoRecord(ctx),
+			"bluesky_search_recent":             tableBlueskySearchRecent(ctx),
+			"bluesky_starter_pack":              tableBlueskyStarterPack(ctx),
+			"bluesky_starter_pack_member":       tableBlueskyStarterPackMember(ctx),
+			"bluesky_suggested_follow":          tableBlueskySuggestedFollow(ctx),
+			"bluesky_user":                      tableBlueskyUser(ctx),
+			"bluesky_user_follower":             tableBlueskyUserFollower(ctx),
+			"bluesky_user_following":            tableBlueskyUserFollowing(ctx),
+			"bluesky_user_like":                 tableBlueskyUserLike(ctx),
+			"bluesky_user_mention":              tableBlueskyUserMention(ctx),
+			"bluesky_user_post":                 tableBlueskyUserPost(ctx),
+			"bluesky_user_repost":               tableBlueskyUserRepost(ctx),
 		},
 	}
 	return p
@@ -0,0 +1,28 @@
+package bluesky
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+// TestPluginTablesColumnNamesUnique guards against tables built from shared
+// column helpers declaring a column twice, which Postgres rejects when
+// importing the foreign table.
+func TestPluginTablesColumnNamesUnique(t *testing.T) {
+	// Some tables log while they are built
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	p := Plugin(ctx)
+
+	for name, table := range p.TableMap {
+		seen := map[string]bool{}
+		for _, col := range table.Columns {
+			if seen[col.Name] {
+				t.Errorf("table %s declares column %s more than once", name, col.Name)
+			}
+			seen[col.Name] = true
+		}
+	}
+}
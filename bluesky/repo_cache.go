@@ -0,0 +1,211 @@
+package bluesky
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	atrepo "github.com/bluesky-social/indigo/atproto/repo"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/ipfs/go-cid"
+	car "github.com/ipld/go-car"
+	carutil "github.com/ipld/go-car/util"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// repoCacheLocks serializes updates to each cached repo, keyed by DID.
+var repoCacheLocks sync.Map
+
+// repoCacheDir returns the directory repos are cached in, defaulting to a
+// directory under the user's cache directory.
+func repoCacheDir(config blueskyConfig) (string, error) {
+	if config.RepoCacheDir != nil && *config.RepoCacheDir != "" {
+		return expandHomeDir(*config.RepoCacheDir)
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("repo_cache_dir is not set and no user cache directory is available: %w", err)
+	}
+	return filepath.Join(dir, "steampipe-plugin-bluesky", "repos"), nil
+}
+
+// repoCachePath returns the path of the cached CAR file of a repo.
+func repoCachePath(dir string, did string) string {
+	return filepath.Join(dir, strings.ReplaceAll(did, ":", "_")+".car")
+}
+
+// cachedRepoPath brings the cached CAR file of a repo up to date with its PDS
+// and returns its path. The first call downloads the whole repo with
+// com.atproto.sync.getRepo, and later calls only fetch the blocks written
+// since the cached revision. If the PDS can't be reached, the cached copy is
+// used as is. A cached copy of a repo given by DID is also used when the DID
+// can't be resolved, but handles must resolve to find their cached copy.
+func cachedRepoPath(ctx context.Context, d *plugin.QueryData, repo string) (string, error) {
+	logger := plugin.Logger(ctx)
+
+	config, err := GetConfig(d.Connection)
+	if err != nil {
+		return "", fmt.Errorf("failed to get config: %v", err)
+	}
+	dir, err := repoCacheDir(config)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create repo cache directory %s: %w", dir, err)
+	}
+
+	client, ident, err := repoClient(ctx, repo)
+	if err != nil {
+		// A DID names its cached copy without a lookup, so work offline
+		if did, perr := syntax.ParseDID(strings.TrimPrefix(repo, "@")); perr == nil {
+			path := repoCachePath(dir, did.String())
+			if _, serr := os.Stat(path); serr == nil {
+				logger.Warn("cachedRepoPath: Using cached repo after failed resolution", "error", err, "did", did)
+				return path, nil
+			}
+		}
+		return "", err
+	}
+	did := ident.DID.String()
+	path := repoCachePath(dir, did)
+
+	lock, _ := repoCacheLocks.LoadOrStore(did, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	since := ""
+	if f, err := os.Open(path); err == nil {
+		commit, _, err := atrepo.LoadCommitFromCAR(ctx, f)
+		f.Close()
+		if err != nil {
+			logger.Warn("cachedRepoPath: Refetching unreadable cached repo", "error", err, "path", path)
+		} else {
+			since = commit.Rev
+		}
+	}
+
+	params := map[string]interface{}{
+		"did": did,
+	}
+	if since != "" {
+		params["since"] = since
+	}
+	diff := new(bytes.Buffer)
+	if err := client.Do(ctx, xrpc.Query, "", "com.atproto.sync.getRepo", params, nil, diff); err != nil {
+		if since != "" {
+			logger.Warn("cachedRepoPath: Using cached repo after failed update", "error", err, "did", did)
+			return path, nil
+		}
+		return "", fmt.Errorf("failed to fetch repo %s: %w", repo, err)
+	}
+
+	if err := writeRepoCache(path, diff.Bytes(), since != ""); err != nil {
+		return "", fmt.Errorf("failed to update cached repo %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// writeRepoCache writes a CAR file fetched with getRepo to the cache. When
+// merge is set the CAR only holds blocks written since the cached revision,
+// so it is merged with the blocks already in the cache. Only blocks reachable
+// from the new commit are written, so replaced records and MST nodes are
+// dropped rather than growing the cache with every update.
+func writeRepoCache(path string, fetched []byte, merge bool) error {
+	blocks := map[cid.Cid][]byte{}
+
+	cr, err := car.NewCarReader(bytes.NewReader(fetched))
+	if err != nil {
+		return err
+	}
+	// The fetched CAR's root is the latest commit
+	header := cr.Header
+	if len(header.Roots) == 0 {
+		return fmt.Errorf("fetched repo has no commit")
+	}
+	if err := readCarReaderBlocks(cr, blocks); err != nil {
+		return err
+	}
+
+	if merge {
+		cached, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer cached.Close()
+		cr, err := car.NewCarReader(cached)
+		if err != nil {
+			return err
+		}
+		if err := readCarReaderBlocks(cr, blocks); err != nil {
+			return err
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".repo-*.car")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err := car.WriteHeader(header, tmp); err != nil {
+		return err
+	}
+
+	// Walk the commit, MST nodes and records by following the links in each
+	// block. Links to blocks outside the repo, such as blobs, are skipped.
+	written := map[cid.Cid]bool{}
+	queue := []cid.Cid{header.Roots[0]}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		raw, ok := blocks[c]
+		if !ok || written[c] {
+			continue
+		}
+		written[c] = true
+		if err := carutil.LdWrite(tmp, c.Bytes(), raw); err != nil {
+			return err
+		}
+		if c.Prefix().Codec != cid.DagCBOR {
+			continue
+		}
+		err := cbg.ScanForLinks(bytes.NewReader(raw), func(link cid.Cid) {
+			queue = append(queue, link)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read links of block %s: %w", c, err)
+		}
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// readCarReaderBlocks adds the blocks of a CAR file to blocks, keeping the
+// first copy of any block seen twice.
+func readCarReaderBlocks(cr *car.CarReader, blocks map[cid.Cid][]byte) error {
+	for {
+		blk, err := cr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := blocks[blk.Cid()]; !ok {
+			blocks[blk.Cid()] = blk.RawData()
+		}
+	}
+}
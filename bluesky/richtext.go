@@ -0,0 +1,157 @@
+package bluesky
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+)
+
+// The patterns below follow the rich text detection of the reference
+// @atproto/api client, adapted to RE2. Offsets of matches are UTF-8 byte
+// offsets, the same unit facets use.
+var (
+	mentionRegex = regexp.MustCompile(`(^|\s|\()(@)([a-zA-Z0-9.-]+)\b`)
+	linkRegex    = regexp.MustCompile(`(?im)(^|\s|\()((https?://\S+)|(([a-z][a-z0-9]*(\.[a-z0-9]+)+)\S*))`)
+	tagRegex     = regexp.MustCompile(`(^|\s)([#＃])([^\s\x{00AD}\x{2060}\x{200A}\x{200B}\x{200C}\x{200D}\x{20E2}]*[^\d\s\p{P}\x{00AD}\x{2060}\x{200A}\x{200B}\x{200C}\x{200D}\x{20E2}]+[^\s\x{00AD}\x{2060}\x{200A}\x{200B}\x{200C}\x{200D}\x{20E2}]*)?`)
+
+	domainLabelRegex       = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	tldRegex               = regexp.MustCompile(`^[a-z]{2,}$`)
+	trailingPunctuationTag = regexp.MustCompile(`\p{P}+$`)
+)
+
+// detectedFacet is a mention, link or tag found in post text by
+// detectRichText, with its span as UTF-8 byte offsets.
+type detectedFacet struct {
+	Type  string
+	Value string
+	Start int
+	End   int
+}
+
+// detectRichText finds the mentions, links and tags in text the way Bluesky
+// clients do when creating facets. The reference client checks domains
+// against the IANA list of TLDs; here any alphabetic TLD of two or more
+// letters is accepted.
+func detectRichText(text string) []detectedFacet {
+	var facets []detectedFacet
+
+	for _, m := range mentionRegex.FindAllStringSubmatchIndex(text, -1) {
+		handle := text[m[6]:m[7]]
+		if !isValidDomain(handle) && !strings.HasSuffix(handle, ".test") {
+			continue
+		}
+		facets = append(facets, detectedFacet{Type: "mention", Value: strings.ToLower(handle), Start: m[4], End: m[7]})
+	}
+
+	for _, m := range linkRegex.FindAllStringSubmatchIndex(text, -1) {
+		start, end := m[4], m[5]
+		uri := text[start:end]
+		if m[6] < 0 {
+			// Bare domains are only links if the domain is valid
+			if !isValidDomain(text[m[10]:m[11]]) {
+				continue
+			}
+			uri = "https://" + uri
+		}
+
+		// Trailing punctuation usually ends the sentence rather than the link
+		if strings.ContainsAny(uri[len(uri)-1:], ".,;:!?") {
+			uri, end = uri[:len(uri)-1], end-1
+		}
+		if strings.HasSuffix(uri, ")") && !strings.Contains(uri, "(") {
+			uri, end = uri[:len(uri)-1], end-1
+		}
+		facets = append(facets, detectedFacet{Type: "link", Value: uri, Start: start, End: end})
+	}
+
+	for _, m := range tagRegex.FindAllStringSubmatchIndex(text, -1) {
+		if m[6] < 0 || strings.HasPrefix(text[m[6]:m[7]], "\uFE0F") {
+			continue
+		}
+		tag := trailingPunctuationTag.ReplaceAllString(strings.TrimSpace(text[m[6]:m[7]]), "")
+		if tag == "" || utf8.RuneCountInString(tag) > 64 {
+			continue
+		}
+		facets = append(facets, detectedFacet{Type: "tag", Value: tag, Start: m[4], End: m[6] + len(tag)})
+	}
+
+	return facets
+}
+
+// isValidDomain reports whether s looks like a domain name with at least two
+// labels and an alphabetic TLD.
+func isValidDomain(s string) bool {
+	labels := strings.Split(strings.ToLower(s), ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if len(label) > 63 || !domainLabelRegex.MatchString(label) {
+			return false
+		}
+	}
+	return tldRegex.MatchString(labels[len(labels)-1])
+}
+
+// postDetectedItem returns the detected_hashtags, detected_links and
+// detected_mentions fields of a row. They are only set when the post text
+// holds a mention, link or tag that none of the post's facets cover, such as
+// for posts from clients that don't write facets.
+func postDetectedItem(post *bsky.FeedPost) map[string]interface{} {
+	item := map[string]interface{}{}
+
+	detected := detectRichText(post.Text)
+	uncovered := false
+	for _, df := range detected {
+		if !facetCovers(post.Facets, df) {
+			uncovered = true
+			break
+		}
+	}
+	if !uncovered {
+		return item
+	}
+
+	hashtags, links, mentions := []string{}, []string{}, []string{}
+	for _, df := range detected {
+		switch df.Type {
+		case "tag":
+			hashtags = append(hashtags, df.Value)
+		case "link":
+			links = append(links, df.Value)
+		case "mention":
+			mentions = append(mentions, df.Value)
+		}
+	}
+	item["detected_hashtags"] = hashtags
+	item["detected_links"] = links
+	item["detected_mentions"] = mentions
+	return item
+}
+
+// facetCovers reports whether a facet of the same type as df overlaps its
+// span.
+func facetCovers(facets []*bsky.RichtextFacet, df detectedFacet) bool {
+	for _, facet := range facets {
+		if facet == nil || facet.Index == nil {
+			continue
+		}
+		if int(facet.Index.ByteStart) >= df.End || int(facet.Index.ByteEnd) <= df.Start {
+			continue
+		}
+		for _, feature := range facet.Features {
+			if feature == nil {
+				continue
+			}
+			switch {
+			case df.Type == "mention" && feature.RichtextFacet_Mention != nil,
+				df.Type == "link" && feature.RichtextFacet_Link != nil,
+				df.Type == "tag" && feature.RichtextFacet_Tag != nil:
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyCarBlock(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_car_block",
+		Description: "Blocks in a local CAR repository export or a cached repository.",
+		List: &plugin.ListConfig{
+			Hydrate: listCarBlock,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "path",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: carRecordColumns("block",
+			&plugin.Column{Name: "subject_did", Type: proto.ColumnType_STRING, Description: "The DID of the blocked user.", Transform: transform.FromField("subject_did")},
+		),
+	}
+}
+
+func listCarBlock(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	err := streamCarRecords(ctx, d, "app.bsky.graph.block", func(record *carRecord) map[string]interface{} {
+		var block graphSubjectRecord
+		if err := json.Unmarshal(record.Value, &block); err != nil {
+			logger.Warn("listCarBlock: Skipping undecodable block", "error", err, "rkey", record.Rkey)
+			return nil
+		}
+
+		item := carRecordItem(record, block.CreatedAt)
+		item["subject_did"] = block.Subject
+		return item
+	})
+	if err != nil {
+		logger.Error("listCarBlock: Failed to read CAR blocks", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,64 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyCarFollow(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_car_follow",
+		Description: "Follows in a local CAR repository export or a cached repository.",
+		List: &plugin.ListConfig{
+			Hydrate: listCarFollow,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "path",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: carRecordColumns("follow",
+			&plugin.Column{Name: "subject_did", Type: proto.ColumnType_STRING, Description: "The DID of the followed user.", Transform: transform.FromField("subject_did")},
+		),
+	}
+}
+
+// graphSubjectRecord holds the fields shared by app.bsky.graph.follow and
+// app.bsky.graph.block records, whose subject is a DID.
+type graphSubjectRecord struct {
+	Subject   string `json:"subject"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func listCarFollow(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	err := streamCarRecords(ctx, d, "app.bsky.graph.follow", func(record *carRecord) map[string]interface{} {
+		var follow graphSubjectRecord
+		if err := json.Unmarshal(record.Value, &follow); err != nil {
+			logger.Warn("listCarFollow: Skipping undecodable follow", "error", err, "rkey", record.Rkey)
+			return nil
+		}
+
+		item := carRecordItem(record, follow.CreatedAt)
+		item["subject_did"] = follow.Subject
+		return item
+	})
+	if err != nil {
+		logger.Error("listCarFollow: Failed to read CAR follows", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
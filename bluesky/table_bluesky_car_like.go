@@ -0,0 +1,61 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyCarLike(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_car_like",
+		Description: "Likes in a local CAR repository export or a cached repository.",
+		List: &plugin.ListConfig{
+			Hydrate: listCarLike,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "path",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: carRecordColumns("like",
+			&plugin.Column{Name: "subject_uri", Type: proto.ColumnType_STRING, Description: "The URI of the liked post.", Transform: transform.FromField("subject_uri")},
+			&plugin.Column{Name: "subject_cid", Type: proto.ColumnType_STRING, Description: "The CID of the liked post.", Transform: transform.FromField("subject_cid")},
+		),
+	}
+}
+
+func listCarLike(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	err := streamCarRecords(ctx, d, "app.bsky.feed.like", func(record *carRecord) map[string]interface{} {
+		var like subjectRecord
+		if err := json.Unmarshal(record.Value, &like); err != nil {
+			logger.Warn("listCarLike: Skipping undecodable like", "error", err, "rkey", record.Rkey)
+			return nil
+		}
+
+		item := carRecordItem(record, like.CreatedAt)
+		if like.Subject != nil {
+			item["subject_uri"] = like.Subject.Uri
+			item["subject_cid"] = like.Subject.Cid
+		}
+		return item
+	})
+	if err != nil {
+		logger.Error("listCarLike: Failed to read CAR likes", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,103 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyCarPost(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_car_post",
+		Description: "Posts in a local CAR repository export or a cached repository.",
+		List: &plugin.ListConfig{
+			Hydrate: listCarPost,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "path",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: carRecordColumns("post", append([]*plugin.Column{
+			{Name: "text", Type: proto.ColumnType_STRING, Description: "The text content of the post.", Transform: transform.FromField("text")},
+			{Name: "reply_root", Type: proto.ColumnType_STRING, Description: "The URI of the root post if this is a reply.", Transform: transform.FromField("reply_root")},
+			{Name: "reply_parent", Type: proto.ColumnType_STRING, Description: "The URI of the parent post if this is a reply.", Transform: transform.FromField("reply_parent")},
+			{Name: "has_external_links", Type: proto.ColumnType_BOOL, Description: "Whether the post contains external links.", Transform: transform.FromField("has_external_links")},
+			{Name: "image_count", Type: proto.ColumnType_INT, Description: "Number of images in the post.", Transform: transform.FromField("image_count")},
+			{Name: "hashtags", Type: proto.ColumnType_JSON, Description: "List of hashtags in the post.", Transform: transform.FromField("hashtags")},
+			{Name: "mentioned_dids", Type: proto.ColumnType_JSON, Description: "List of DIDs of users mentioned in the post.", Transform: transform.FromField("mentioned_dids")},
+			{Name: "external_links", Type: proto.ColumnType_JSON, Description: "List of external links in the post.", Transform: transform.FromField("external_links")},
+		}, postRecordColumns()...)...),
+	}
+}
+
+// carRecordColumns returns the columns shared by the typed tables over CAR
+// repository exports, followed by any record specific columns.
+func carRecordColumns(kind string, extraCols ...*plugin.Column) []*plugin.Column {
+	cols := []*plugin.Column{
+		{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the " + kind + " record.", Transform: transform.FromField("uri")},
+		{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the " + kind + " record.", Transform: transform.FromField("cid")},
+		{Name: "rkey", Type: proto.ColumnType_STRING, Description: "The record key of the " + kind + " record.", Transform: transform.FromField("rkey")},
+		{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the repository.", Transform: transform.FromField("did")},
+		{Name: "created_at", Type: proto.ColumnType_STRING, Description: "When the " + kind + " was created.", Transform: transform.FromField("created_at")},
+	}
+	cols = append(cols, extraCols...)
+	return append(cols,
+		&plugin.Column{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the CAR file.", Transform: transform.FromField("path")},
+		&plugin.Column{Name: "repo", Type: proto.ColumnType_STRING, Description: "The DID or handle of the repository, when read from the repo cache.", Transform: transform.FromField("repo")},
+	)
+}
+
+// carRecordItem builds the shared carRecordColumns fields of a row.
+func carRecordItem(record *carRecord, createdAt string) map[string]interface{} {
+	return map[string]interface{}{
+		"uri":        record.Uri(),
+		"cid":        record.Cid,
+		"rkey":       record.Rkey,
+		"did":        record.Did,
+		"created_at": createdAt,
+	}
+}
+
+func listCarPost(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	err := streamCarRecords(ctx, d, "app.bsky.feed.post", func(record *carRecord) map[string]interface{} {
+		var post bsky.FeedPost
+		if err := json.Unmarshal(record.Value, &post); err != nil {
+			logger.Warn("listCarPost: Skipping undecodable post", "error", err, "rkey", record.Rkey)
+			return nil
+		}
+
+		metadata := extractPostMetadata(&post)
+		item := carRecordItem(record, post.CreatedAt)
+		item["text"] = post.Text
+		item["reply_root"] = getReplyRoot(&post)
+		item["reply_parent"] = getReplyParent(&post)
+		item["has_external_links"] = metadata["has_external_links"]
+		item["image_count"] = metadata["image_count"]
+		item["hashtags"] = metadata["hashtags"]
+		item["mentioned_dids"] = metadata["mentioned_handles"]
+		item["external_links"] = metadata["external_links"]
+		maps.Copy(item, postRecordItem(record.Did, &post))
+		return item
+	})
+	if err != nil {
+		logger.Error("listCarPost: Failed to read CAR posts", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,190 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bluesky-social/indigo/atproto/data"
+	atrepo "github.com/bluesky-social/indigo/atproto/repo"
+	"github.com/ipfs/go-cid"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyCarRecord(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_car_record",
+		Description: "Records of every collection in a local CAR repository export or a cached repository.",
+		List: &plugin.ListConfig{
+			Hydrate: listCarRecord,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "path",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "collection",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: repoRecordColumns(
+			&plugin.Column{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the repository.", Transform: transform.FromField("did")},
+			&plugin.Column{Name: "rev", Type: proto.ColumnType_STRING, Description: "The revision of the repository commit in the export.", Transform: transform.FromField("rev")},
+			&plugin.Column{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the CAR file.", Transform: transform.FromField("path")},
+			&plugin.Column{Name: "repo", Type: proto.ColumnType_STRING, Description: "The DID or handle of the repository, when read from the repo cache.", Transform: transform.FromField("repo")},
+		),
+	}
+}
+
+// carRecord is a record read from a CAR repository export, with its value
+// converted from CBOR to atproto JSON.
+type carRecord struct {
+	Did        string
+	Rev        string
+	Collection string
+	Rkey       string
+	Cid        string
+	Value      json.RawMessage
+}
+
+// Uri returns the at:// URI of the record.
+func (r *carRecord) Uri() string {
+	return fmt.Sprintf("at://%s/%s/%s", r.Did, r.Collection, r.Rkey)
+}
+
+// errStopWalk is returned by walkCarRecords callbacks to stop early.
+var errStopWalk = errors.New("stop walk")
+
+// walkCarRecords walks the MST of a CAR repository export, calling fn for
+// every record, or only those of the given collection if it isn't empty.
+// Records that are missing or can't be decoded are logged and skipped.
+func walkCarRecords(ctx context.Context, path string, collection string, fn func(record *carRecord) error) error {
+	logger := plugin.Logger(ctx)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open CAR file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	commit, repo, err := atrepo.LoadRepoFromCAR(ctx, f)
+	if err != nil {
+		return fmt.Errorf("failed to read CAR file %s: %w", path, err)
+	}
+
+	err = repo.MST.Walk(func(key []byte, val cid.Cid) error {
+		nsid, rkey, ok := strings.Cut(string(key), "/")
+		if !ok || (collection != "" && nsid != collection) {
+			return nil
+		}
+
+		// A bad record shouldn't hide the rest of the export, so only
+		// structural failures of the CAR file or MST are errors
+		blk, err := repo.RecordStore.Get(ctx, val)
+		if err != nil {
+			logger.Warn("walkCarRecords: Skipping record missing from CAR file", "error", err, "key", string(key), "path", path)
+			return nil
+		}
+		obj, err := data.UnmarshalCBOR(blk.RawData())
+		if err != nil {
+			logger.Warn("walkCarRecords: Skipping undecodable record", "error", err, "key", string(key), "path", path)
+			return nil
+		}
+		value, err := json.Marshal(obj)
+		if err != nil {
+			logger.Warn("walkCarRecords: Skipping unencodable record", "error", err, "key", string(key), "path", path)
+			return nil
+		}
+
+		return fn(&carRecord{
+			Did:        commit.DID,
+			Rev:        commit.Rev,
+			Collection: nsid,
+			Rkey:       rkey,
+			Cid:        val.String(),
+			Value:      value,
+		})
+	})
+	if errors.Is(err, errStopWalk) {
+		return nil
+	}
+	return err
+}
+
+// streamCarRecords streams a row built by itemFn for every record of a
+// collection in each CAR file given in the path qual, and in the cached copy
+// of each repo given in the repo qual. Records for which itemFn returns nil
+// are skipped.
+func streamCarRecords(ctx context.Context, d *plugin.QueryData, collection string, itemFn func(record *carRecord) map[string]interface{}) error {
+	paths := qualStringValues(d, "path")
+	repos := map[string]string{}
+	for _, repo := range qualStringValues(d, "repo") {
+		path, err := cachedRepoPath(ctx, d, repo)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, path)
+		repos[path] = repo
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("path or repo must be specified")
+	}
+
+	for _, path := range paths {
+		done := false
+		err := walkCarRecords(ctx, path, collection, func(record *carRecord) error {
+			item := itemFn(record)
+			if item == nil {
+				return nil
+			}
+			item["path"] = path
+			if repo, ok := repos[path]; ok {
+				// Keep the qual value as given so the key column matches
+				item["repo"] = repo
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				done = true
+				return errStopWalk
+			}
+			return nil
+		})
+		if err != nil || done {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listCarRecord(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	collection := d.EqualsQualString("collection")
+	err := streamCarRecords(ctx, d, collection, func(record *carRecord) map[string]interface{} {
+		item := repoRecordItem(record.Uri(), record.Cid, record.Collection, record.Rkey, record.Value)
+		item["did"] = record.Did
+		item["rev"] = record.Rev
+		return item
+	})
+	if err != nil {
+		logger.Error("listCarRecord: Failed to read CAR records", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
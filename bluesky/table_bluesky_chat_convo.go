@@ -0,0 +1,155 @@
+package bluesky
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/chat"
+	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// chatServiceProxy is the service the PDS forwards chat.bsky.* calls to.
+const chatServiceProxy = "did:web:api.bsky.chat#bsky_chat"
+
+func tableBlueskyChatConvo(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_chat_convo",
+		Description: "Direct message conversations of the authenticated Bluesky user.",
+		List: &plugin.ListConfig{
+			Hydrate: listChatConvo,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the conversation.", Transform: transform.FromField("id")},
+			{Name: "rev", Type: proto.ColumnType_STRING, Description: "The revision of the conversation.", Transform: transform.FromField("rev")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the conversation. Possible values are: request, accepted.", Transform: transform.FromField("status")},
+			{Name: "muted", Type: proto.ColumnType_BOOL, Description: "Whether the authenticated user muted the conversation.", Transform: transform.FromField("muted")},
+			{Name: "unread_count", Type: proto.ColumnType_INT, Description: "Number of unread messages in the conversation.", Transform: transform.FromField("unread_count")},
+			{Name: "members", Type: proto.ColumnType_JSON, Description: "The members of the conversation, with their DID, handle and display name.", Transform: transform.FromField("members")},
+			{Name: "member_handles", Type: proto.ColumnType_JSON, Description: "List of handles of the members of the conversation.", Transform: transform.FromField("member_handles")},
+			{Name: "last_message_id", Type: proto.ColumnType_STRING, Description: "The ID of the last message in the conversation.", Transform: transform.FromField("last_message_id")},
+			{Name: "last_message_text", Type: proto.ColumnType_STRING, Description: "The text of the last message in the conversation.", Transform: transform.FromField("last_message_text")},
+			{Name: "last_message_sender_did", Type: proto.ColumnType_STRING, Description: "The DID of the sender of the last message.", Transform: transform.FromField("last_message_sender_did")},
+			{Name: "last_message_sent_at", Type: proto.ColumnType_STRING, Description: "When the last message was sent.", Transform: transform.FromField("last_message_sent_at")},
+		},
+	}
+}
+
+// chatClient returns a copy of the authenticated client that routes calls
+// through the PDS to the chat service.
+func chatClient(ctx context.Context, d *plugin.QueryData) (*xrpc.Client, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *client
+	c.Headers = map[string]string{}
+	for k, v := range client.Headers {
+		c.Headers[k] = v
+	}
+	c.Headers["atproto-proxy"] = chatServiceProxy
+	return &c, nil
+}
+
+// chatError explains the most common reason chat calls are refused: an app
+// password created without direct message access.
+func chatError(err error) error {
+	var xe *xrpc.Error
+	if errors.As(err, &xe) && (xe.StatusCode == 401 || xe.StatusCode == 403) {
+		return fmt.Errorf("access to direct messages was denied, check the app_password was created with \"Allow access to your direct messages\" enabled: %w", err)
+	}
+	return err
+}
+
+func listChatConvo(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	status := d.EqualsQualString("status")
+	if status != "" && status != "request" && status != "accepted" {
+		logger.Error("listChatConvo: Invalid status", "status", status)
+		return nil, fmt.Errorf("status must be one of: request, accepted")
+	}
+
+	client, err := chatClient(ctx, d)
+	if err != nil {
+		logger.Error("listChatConvo: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	cursor := ""
+	for {
+		out, err := chat.ConvoListConvos(ctx, client, cursor, 100, "", status)
+		if err != nil {
+			logger.Error("listChatConvo: Failed to list conversations", "error", err)
+			return nil, fmt.Errorf("failed to list conversations: %w", chatError(err))
+		}
+
+		for _, convo := range out.Convos {
+			members := make([]map[string]interface{}, 0, len(convo.Members))
+			handles := make([]string, 0, len(convo.Members))
+			for _, member := range convo.Members {
+				members = append(members, map[string]interface{}{
+					"did":          member.Did,
+					"handle":       member.Handle,
+					"display_name": derefString(member.DisplayName),
+				})
+				handles = append(handles, member.Handle)
+			}
+
+			item := map[string]interface{}{
+				"id":             convo.Id,
+				"rev":            convo.Rev,
+				"status":         derefString(convo.Status),
+				"muted":          convo.Muted,
+				"unread_count":   convo.UnreadCount,
+				"members":        members,
+				"member_handles": handles,
+			}
+			if convo.LastMessage != nil {
+				if msg := convo.LastMessage.ConvoDefs_MessageView; msg != nil {
+					item["last_message_id"] = msg.Id
+					item["last_message_text"] = msg.Text
+					item["last_message_sent_at"] = msg.SentAt
+					if msg.Sender != nil {
+						item["last_message_sender_did"] = msg.Sender.Did
+					}
+				} else if msg := convo.LastMessage.ConvoDefs_DeletedMessageView; msg != nil {
+					item["last_message_id"] = msg.Id
+					item["last_message_sent_at"] = msg.SentAt
+					if msg.Sender != nil {
+						item["last_message_sender_did"] = msg.Sender.Did
+					}
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Convos) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
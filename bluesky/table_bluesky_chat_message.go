@@ -0,0 +1,130 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/chat"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyChatMessage(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_chat_message",
+		Description: "Messages in a direct message conversation of the authenticated Bluesky user.",
+		List: &plugin.ListConfig{
+			Hydrate: listChatMessage,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "convo_id",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the message.", Transform: transform.FromField("id")},
+			{Name: "convo_id", Type: proto.ColumnType_STRING, Description: "The ID of the conversation.", Transform: transform.FromField("convo_id")},
+			{Name: "rev", Type: proto.ColumnType_STRING, Description: "The revision of the message.", Transform: transform.FromField("rev")},
+			{Name: "sender_did", Type: proto.ColumnType_STRING, Description: "The DID of the sender.", Transform: transform.FromField("sender_did")},
+			{Name: "sender_handle", Type: proto.ColumnType_STRING, Description: "The handle of the sender.", Transform: transform.FromField("sender_handle")},
+			{Name: "text", Type: proto.ColumnType_STRING, Description: "The text of the message.", Transform: transform.FromField("text")},
+			{Name: "facets", Type: proto.ColumnType_JSON, Description: "Rich text facets, such as mentions and links, in the message.", Transform: transform.FromField("facets")},
+			{Name: "embed", Type: proto.ColumnType_JSON, Description: "The record embedded in the message, if any.", Transform: transform.FromField("embed")},
+			{Name: "reactions", Type: proto.ColumnType_JSON, Description: "Emoji reactions to the message.", Transform: transform.FromField("reactions")},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Whether the message was deleted.", Transform: transform.FromField("is_deleted")},
+			{Name: "sent_at", Type: proto.ColumnType_STRING, Description: "When the message was sent.", Transform: transform.FromField("sent_at")},
+		},
+	}
+}
+
+func listChatMessage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	convoID := d.EqualsQualString("convo_id")
+	if convoID == "" {
+		logger.Error("listChatMessage: No convo_id specified")
+		return nil, fmt.Errorf("convo_id must be specified")
+	}
+
+	client, err := chatClient(ctx, d)
+	if err != nil {
+		logger.Error("listChatMessage: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Messages only carry the sender's DID, so map members to their handles
+	convo, err := chat.ConvoGetConvo(ctx, client, convoID)
+	if err != nil {
+		logger.Error("listChatMessage: Failed to get conversation", "error", err, "convo_id", convoID)
+		return nil, fmt.Errorf("failed to get conversation %s: %w", convoID, chatError(err))
+	}
+	handles := map[string]string{}
+	if convo.Convo != nil {
+		for _, member := range convo.Convo.Members {
+			handles[member.Did] = member.Handle
+		}
+	}
+
+	cursor := ""
+	for {
+		out, err := chat.ConvoGetMessages(ctx, client, convoID, cursor, 100)
+		if err != nil {
+			logger.Error("listChatMessage: Failed to get messages", "error", err, "convo_id", convoID)
+			return nil, fmt.Errorf("failed to get messages for conversation %s: %w", convoID, chatError(err))
+		}
+
+		for _, message := range out.Messages {
+			item := map[string]interface{}{
+				"convo_id": convoID,
+			}
+			if msg := message.ConvoDefs_MessageView; msg != nil {
+				item["id"] = msg.Id
+				item["rev"] = msg.Rev
+				item["text"] = msg.Text
+				item["facets"] = msg.Facets
+				item["reactions"] = msg.Reactions
+				item["sent_at"] = msg.SentAt
+				if msg.Embed != nil {
+					item["embed"] = msg.Embed.EmbedRecord_View
+				}
+				if msg.Sender != nil {
+					item["sender_did"] = msg.Sender.Did
+				}
+			} else if msg := message.ConvoDefs_DeletedMessageView; msg != nil {
+				item["id"] = msg.Id
+				item["rev"] = msg.Rev
+				item["sent_at"] = msg.SentAt
+				item["is_deleted"] = true
+				if msg.Sender != nil {
+					item["sender_did"] = msg.Sender.Did
+				}
+			} else {
+				continue
+			}
+			if did, ok := item["sender_did"].(string); ok {
+				item["sender_handle"] = handles[did]
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Messages) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,92 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyFeed(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_feed",
+		Description: "List of posts served by a custom feed generator.",
+		List: &plugin.ListConfig{
+			Hydrate: listFeed,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "feed_uri",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          postColumns("feed_uri", "feed_reason"),
+	}
+}
+
+func listFeed(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	feedURI := d.EqualsQuals["feed_uri"].GetStringValue()
+	if feedURI == "" {
+		logger.Error("listFeed: No feed_uri specified")
+		return nil, fmt.Errorf("feed_uri must be specified")
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listFeed: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Accept both at:// URIs and bsky.app feed URLs
+	uri, err := convertToAtURI(ctx, client, feedURI)
+	if err != nil {
+		logger.Error("listFeed: Error converting feed URL to URI", "error", err, "feed_uri", feedURI)
+		return nil, fmt.Errorf("failed to convert feed URL to URI: %w", err)
+	}
+	if !strings.Contains(uri, "/app.bsky.feed.generator/") {
+		logger.Error("listFeed: Not a feed generator URI", "uri", uri)
+		return nil, fmt.Errorf("feed_uri must refer to an app.bsky.feed.generator record: %s", feedURI)
+	}
+
+	cursor := ""
+	for {
+		feed, err := bsky.FeedGetFeed(ctx, client, cursor, uri, 100)
+		if err != nil {
+			logger.Error("listFeed: Failed to get feed", "error", err, "uri", uri)
+			return nil, fmt.Errorf("failed to get feed %s: %w", uri, err)
+		}
+
+		for _, feedItem := range feed.Feed {
+			item := feedViewPostItem(ctx, client, feedItem)
+			if item == nil {
+				continue
+			}
+			// Keep the qual value as given so the key column matches
+			item["feed_uri"] = feedURI
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if feed.Cursor == nil || *feed.Cursor == "" || len(feed.Feed) == 0 {
+			break
+		}
+		cursor = *feed.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
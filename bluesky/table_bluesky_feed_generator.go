@@ -0,0 +1,202 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyFeedGenerator(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_feed_generator",
+		Description: "Metadata about Bluesky custom feed generators. Lists suggested feeds unless a uri, http_url, creator_did or creator_handle is specified.",
+		List: &plugin.ListConfig{
+			Hydrate: listFeedGenerator,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "uri",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "http_url",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "creator_did",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "creator_handle",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the feed generator record.", Transform: transform.FromField("uri")},
+			{Name: "http_url", Type: proto.ColumnType_STRING, Description: "The HTTP URL for the feed on bsky.app.", Transform: transform.FromField("http_url")},
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the feed generator record.", Transform: transform.FromField("cid")},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the feed.", Transform: transform.FromField("display_name")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the feed.", Transform: transform.FromField("description")},
+			{Name: "creator_did", Type: proto.ColumnType_STRING, Description: "The DID of the user who published the feed.", Transform: transform.FromField("creator_did")},
+			{Name: "creator_handle", Type: proto.ColumnType_STRING, Description: "The handle of the user who published the feed.", Transform: transform.FromField("creator_handle")},
+			{Name: "service_did", Type: proto.ColumnType_STRING, Description: "The DID of the service that generates the feed.", Transform: transform.FromField("service_did")},
+			{Name: "like_count", Type: proto.ColumnType_INT, Description: "Number of likes on the feed.", Transform: transform.FromField("like_count")},
+			{Name: "accepts_interactions", Type: proto.ColumnType_BOOL, Description: "Whether the feed generator accepts interaction feedback from clients.", Transform: transform.FromField("accepts_interactions")},
+			{Name: "content_mode", Type: proto.ColumnType_STRING, Description: "The kind of content the feed serves, e.g. app.bsky.feed.defs#contentModeVideo.", Transform: transform.FromField("content_mode")},
+			{Name: "avatar", Type: proto.ColumnType_STRING, Description: "URL of the feed's avatar image.", Transform: transform.FromField("avatar")},
+			{Name: "indexed_at", Type: proto.ColumnType_STRING, Description: "When the feed generator was indexed.", Transform: transform.FromField("indexed_at")},
+			{Name: "viewer_like", Type: proto.ColumnType_STRING, Description: "The URI of the authenticated user's like of the feed, if any.", Transform: transform.FromField("viewer_like")},
+			{Name: "is_online", Type: proto.ColumnType_BOOL, Description: "Whether the feed generator service has been online recently.", Hydrate: getFeedGeneratorStatus, Transform: transform.FromField("IsOnline")},
+			{Name: "is_valid", Type: proto.ColumnType_BOOL, Description: "Whether the feed generator service is compatible with its record declaration.", Hydrate: getFeedGeneratorStatus, Transform: transform.FromField("IsValid")},
+		},
+	}
+}
+
+// generatorViewItem builds a feed generator row from a generator view.
+func generatorViewItem(view *bsky.FeedDefs_GeneratorView) map[string]interface{} {
+	item := map[string]interface{}{
+		"uri":          view.Uri,
+		"http_url":     convertToHttpUrl(view.Uri),
+		"cid":          view.Cid,
+		"display_name": view.DisplayName,
+		"description":  derefString(view.Description),
+		"service_did":  view.Did,
+		"like_count":   derefInt64(view.LikeCount),
+		"content_mode": derefString(view.ContentMode),
+		"avatar":       derefString(view.Avatar),
+		"indexed_at":   view.IndexedAt,
+	}
+	if view.AcceptsInteractions != nil {
+		item["accepts_interactions"] = *view.AcceptsInteractions
+	}
+	if view.Creator != nil {
+		item["creator_did"] = view.Creator.Did
+		item["creator_handle"] = view.Creator.Handle
+	}
+	if view.Viewer != nil {
+		item["viewer_like"] = derefString(view.Viewer.Like)
+	}
+	return item
+}
+
+func listFeedGenerator(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listFeedGenerator: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Look up specific feeds by URI or bsky.app URL
+	uri := d.EqualsQualString("uri")
+	httpUrl := d.EqualsQualString("http_url")
+	fromHttpUrl := uri == "" && httpUrl != ""
+	if fromHttpUrl {
+		uri, err = convertToAtURI(ctx, client, httpUrl)
+		if err != nil {
+			logger.Error("listFeedGenerator: Error converting HTTP URL to URI", "error", err, "http_url", httpUrl)
+			return nil, fmt.Errorf("failed to convert HTTP URL to URI: %w", err)
+		}
+	}
+	if uri != "" {
+		out, err := bsky.FeedGetFeedGenerators(ctx, client, []string{uri})
+		if err != nil {
+			logger.Error("listFeedGenerator: Failed to get feed generators", "error", err)
+			return nil, fmt.Errorf("failed to get feed generators: %w", err)
+		}
+		for _, view := range out.Feeds {
+			item := generatorViewItem(view)
+			// Keep the qual value as given so the key column matches
+			if fromHttpUrl {
+				item["http_url"] = httpUrl
+			}
+			d.StreamListItem(ctx, item)
+		}
+		return nil, nil
+	}
+
+	// List every feed published by one actor
+	actor := d.EqualsQualString("creator_did")
+	if actor != "" && !strings.HasPrefix(actor, "did:") {
+		logger.Error("listFeedGenerator: Invalid DID format", "did", actor)
+		return nil, fmt.Errorf("invalid DID format: %s", actor)
+	}
+	if actor == "" {
+		actor = strings.TrimPrefix(d.EqualsQualString("creator_handle"), "@")
+	}
+
+	cursor := ""
+	for {
+		var feeds []*bsky.FeedDefs_GeneratorView
+		var next *string
+		if actor != "" {
+			out, err := bsky.FeedGetActorFeeds(ctx, client, actor, cursor, 100)
+			if err != nil {
+				logger.Error("listFeedGenerator: Failed to get actor feeds", "error", err, "actor", actor)
+				return nil, fmt.Errorf("failed to get feeds for %s: %w", actor, err)
+			}
+			feeds, next = out.Feeds, out.Cursor
+		} else {
+			out, err := bsky.FeedGetSuggestedFeeds(ctx, client, cursor, 100)
+			if err != nil {
+				logger.Error("listFeedGenerator: Failed to get suggested feeds", "error", err)
+				return nil, fmt.Errorf("failed to get suggested feeds: %w", err)
+			}
+			feeds, next = out.Feeds, out.Cursor
+		}
+
+		for _, view := range feeds {
+			d.StreamListItem(ctx, generatorViewItem(view))
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if next == nil || *next == "" || len(feeds) == 0 {
+			break
+		}
+		cursor = *next
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
+
+// getFeedGeneratorStatus fetches the online and validity status of a feed
+// generator, which is only returned when looking up a single feed.
+func getFeedGeneratorStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	item := h.Item.(map[string]interface{})
+	uri, _ := item["uri"].(string)
+	if uri == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("getFeedGeneratorStatus: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	out, err := bsky.FeedGetFeedGenerator(ctx, client, uri)
+	if err != nil {
+		logger.Error("getFeedGeneratorStatus: Failed to get feed generator", "error", err, "uri", uri)
+		return nil, fmt.Errorf("failed to get feed generator %s: %w", uri, err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,316 @@
+package bluesky
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	comatproto "github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/data"
+	"github.com/gorilla/websocket"
+	car "github.com/ipld/go-car"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+func tableBlueskyFirehose(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_firehose",
+		Description: "Repository operations read live from a relay's com.atproto.sync.subscribeRepos firehose.",
+		List: &plugin.ListConfig{
+			Hydrate: listFirehose,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "limit",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "duration",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "cursor",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "seq", Type: proto.ColumnType_INT, Description: "The sequence number of the commit event on the firehose.", Transform: transform.FromField("seq")},
+			{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the repository the commit belongs to.", Transform: transform.FromField("did")},
+			{Name: "rev", Type: proto.ColumnType_STRING, Description: "The revision of the commit.", Transform: transform.FromField("rev")},
+			{Name: "action", Type: proto.ColumnType_STRING, Description: "The operation applied to the record: create, update or delete.", Transform: transform.FromField("action")},
+			{Name: "collection", Type: proto.ColumnType_STRING, Description: "The NSID of the collection holding the record.", Transform: transform.FromField("collection")},
+			{Name: "rkey", Type: proto.ColumnType_STRING, Description: "The record key of the record.", Transform: transform.FromField("rkey")},
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the record.", Transform: transform.FromField("uri")},
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the new record. Null for deletes.", Transform: transform.FromField("cid")},
+			{Name: "record", Type: proto.ColumnType_JSON, Description: "The new record. Null for deletes and for commits sent without blocks.", Transform: transform.FromField("record")},
+			{Name: "time", Type: proto.ColumnType_STRING, Description: "When the commit was broadcast by the relay.", Transform: transform.FromField("time")},
+			{Name: "limit", Type: proto.ColumnType_INT, Description: "The number of commit events to read before stopping.", Transform: transform.FromField("limit")},
+			{Name: "duration", Type: proto.ColumnType_STRING, Description: "How long to read the firehose for, as a duration such as 30s or 5m.", Transform: transform.FromField("duration")},
+			{Name: "cursor", Type: proto.ColumnType_INT, Description: "The sequence number to resume the firehose from.", Transform: transform.FromField("cursor")},
+		},
+	}
+}
+
+// streamBounds returns the event count and duration a live stream is read
+// for. At least one of the limit and duration quals, or a SQL limit,
+// must be given so queries always finish.
+func streamBounds(d *plugin.QueryData) (int64, time.Duration, error) {
+	var maxEvents int64
+	if d.EqualsQuals["limit"] != nil {
+		maxEvents = d.EqualsQuals["limit"].GetInt64Value()
+		if maxEvents <= 0 {
+			return 0, 0, fmt.Errorf("limit must be greater than 0")
+		}
+	}
+
+	var duration time.Duration
+	if s := d.EqualsQualString("duration"); s != "" {
+		var err error
+		duration, err = time.ParseDuration(s)
+		if err != nil || duration <= 0 {
+			return 0, 0, fmt.Errorf("invalid duration %q, expected a positive duration such as 30s or 5m", s)
+		}
+	}
+
+	if maxEvents == 0 && duration == 0 && d.QueryContext.Limit == nil {
+		return 0, 0, fmt.Errorf("limit or duration must be specified, or the query must have a limit clause")
+	}
+	return maxEvents, duration, nil
+}
+
+// streamKeyColumnItem adds the stream bound quals to a row as given, so the
+// key columns match.
+func streamKeyColumnItem(d *plugin.QueryData, item map[string]interface{}) {
+	if d.EqualsQuals["limit"] != nil {
+		item["limit"] = d.EqualsQuals["limit"].GetInt64Value()
+	}
+	item["duration"] = d.EqualsQualString("duration")
+	if d.EqualsQuals["cursor"] != nil {
+		item["cursor"] = d.EqualsQuals["cursor"].GetInt64Value()
+	}
+}
+
+// readStream dials a WebSocket stream and calls fn with each message until
+// fn returns false, the duration elapses or the context is cancelled.
+func readStream(ctx context.Context, streamURL string, duration time.Duration, fn func(msg []byte) (bool, error)) error {
+	if duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, duration)
+		defer cancel()
+	}
+
+	con, _, err := websocket.DefaultDialer.DialContext(ctx, streamURL, nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("failed to connect to %s: %w", streamURL, err)
+	}
+	defer con.Close()
+
+	// Close the connection when the context ends so a pending read returns
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			con.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		_, msg, err := con.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read from %s: %w", streamURL, err)
+		}
+
+		more, err := fn(msg)
+		if err != nil || !more {
+			return err
+		}
+	}
+}
+
+func listFirehose(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	maxEvents, duration, err := streamBounds(d)
+	if err != nil {
+		logger.Error("listFirehose: Invalid bounds", "error", err)
+		return nil, err
+	}
+
+	config, err := GetConfig(d.Connection)
+	if err != nil {
+		logger.Error("listFirehose: Failed to get config", "error", err)
+		return nil, err
+	}
+
+	streamURL := strings.TrimSuffix(*config.RelayHost, "/") + "/xrpc/com.atproto.sync.subscribeRepos"
+	if d.EqualsQuals["cursor"] != nil {
+		streamURL += "?" + url.Values{"cursor": {strconv.FormatInt(d.EqualsQuals["cursor"].GetInt64Value(), 10)}}.Encode()
+	}
+
+	var events int64
+	err = readStream(ctx, streamURL, duration, func(msg []byte) (bool, error) {
+		evt, err := decodeFirehoseFrame(msg)
+		if err != nil {
+			return false, err
+		}
+		if evt == nil {
+			// Not a commit event
+			return true, nil
+		}
+
+		items, err := firehoseCommitItems(evt)
+		if err != nil {
+			logger.Warn("listFirehose: Failed to decode commit", "error", err, "seq", evt.Seq)
+		}
+		for _, item := range items {
+			streamKeyColumnItem(d, item)
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+
+		events++
+		return maxEvents == 0 || events < maxEvents, nil
+	})
+	if err != nil {
+		logger.Error("listFirehose: Failed to read firehose", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// decodeFirehoseFrame decodes a subscribeRepos frame, a CBOR header followed
+// by a CBOR body. It returns nil for frames other than commits, and an error
+// for error frames.
+func decodeFirehoseFrame(msg []byte) (*comatproto.SyncSubscribeRepos_Commit, error) {
+	r := bytes.NewReader(msg)
+
+	var raw cbg.Deferred
+	if err := raw.UnmarshalCBOR(r); err != nil {
+		return nil, fmt.Errorf("failed to read frame header: %w", err)
+	}
+	header, err := data.UnmarshalCBOR(raw.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode frame header: %w", err)
+	}
+
+	if op, _ := header["op"].(int64); op == -1 {
+		body, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read error frame: %w", err)
+		}
+		frame, err := data.UnmarshalCBOR(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode error frame: %w", err)
+		}
+		name, _ := frame["error"].(string)
+		if message, _ := frame["message"].(string); message != "" {
+			return nil, fmt.Errorf("firehose error %s: %s", name, message)
+		}
+		return nil, fmt.Errorf("firehose error %s", name)
+	}
+
+	if t, _ := header["t"].(string); t != "#commit" {
+		return nil, nil
+	}
+
+	var evt comatproto.SyncSubscribeRepos_Commit
+	if err := evt.UnmarshalCBOR(r); err != nil {
+		return nil, fmt.Errorf("failed to decode commit: %w", err)
+	}
+	return &evt, nil
+}
+
+// firehoseCommitItems builds a row for every operation in a commit, taking
+// record values from the CAR blocks sent with the commit. Rows are returned
+// even if the blocks can't be read, without their records.
+func firehoseCommitItems(evt *comatproto.SyncSubscribeRepos_Commit) ([]map[string]interface{}, error) {
+	blocks := map[string][]byte{}
+	var blocksErr error
+	if len(evt.Blocks) > 0 {
+		blocksErr = readCarBlocks(evt.Blocks, blocks)
+	}
+
+	items := []map[string]interface{}{}
+	for _, op := range evt.Ops {
+		collection, rkey, _ := strings.Cut(op.Path, "/")
+		item := map[string]interface{}{
+			"seq":        evt.Seq,
+			"did":        evt.Repo,
+			"rev":        evt.Rev,
+			"action":     op.Action,
+			"collection": collection,
+			"rkey":       rkey,
+			"uri":        fmt.Sprintf("at://%s/%s", evt.Repo, op.Path),
+			"time":       evt.Time,
+		}
+
+		if op.Cid != nil {
+			c := op.Cid.String()
+			item["cid"] = c
+			if blk, ok := blocks[c]; ok {
+				value, err := cborRecordJSON(blk)
+				if err != nil {
+					if blocksErr == nil {
+						blocksErr = fmt.Errorf("failed to decode record %s: %w", op.Path, err)
+					}
+				} else {
+					item["record"] = value
+				}
+			}
+		}
+		items = append(items, item)
+	}
+
+	return items, blocksErr
+}
+
+// cborRecordJSON converts a CBOR record block to atproto JSON.
+func cborRecordJSON(blk []byte) (json.RawMessage, error) {
+	obj, err := data.UnmarshalCBOR(blk)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// readCarBlocks reads every block of a CAR file into blocks, keyed by CID.
+func readCarBlocks(b []byte, blocks map[string][]byte) error {
+	cr, err := car.NewCarReader(bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("failed to read CAR header: %w", err)
+	}
+	for {
+		blk, err := cr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read CAR block: %w", err)
+		}
+		blocks[blk.Cid().String()] = blk.RawData()
+	}
+}
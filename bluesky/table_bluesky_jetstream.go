@@ -0,0 +1,274 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyJetstream(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_jetstream",
+		Description: "Commit, identity and account events read live from a Jetstream instance, filtered by collection and DID.",
+		List: &plugin.ListConfig{
+			Hydrate: listJetstream,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "collection",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "did",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "collections",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "dids",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "limit",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "duration",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "cursor",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compress",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "kind", Type: proto.ColumnType_STRING, Description: "The kind of event: commit, identity or account.", Transform: transform.FromField("kind")},
+			{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the account the event is about.", Transform: transform.FromField("did")},
+			{Name: "time_us", Type: proto.ColumnType_INT, Description: "When Jetstream received the event, in microseconds since the Unix epoch.", Transform: transform.FromField("time_us")},
+			{Name: "time", Type: proto.ColumnType_STRING, Description: "When Jetstream received the event.", Transform: transform.FromField("time")},
+			{Name: "rev", Type: proto.ColumnType_STRING, Description: "The revision of the commit, for commit events.", Transform: transform.FromField("rev")},
+			{Name: "operation", Type: proto.ColumnType_STRING, Description: "The operation applied to the record, for commit events: create, update or delete.", Transform: transform.FromField("operation")},
+			{Name: "collection", Type: proto.ColumnType_STRING, Description: "The NSID of the collection holding the record, for commit events.", Transform: transform.FromField("collection")},
+			{Name: "rkey", Type: proto.ColumnType_STRING, Description: "The record key of the record, for commit events.", Transform: transform.FromField("rkey")},
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the record, for commit events.", Transform: transform.FromField("uri")},
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the new record, for create and update commit events.", Transform: transform.FromField("cid")},
+			{Name: "record", Type: proto.ColumnType_JSON, Description: "The new record, for create and update commit events.", Transform: transform.FromField("record")},
+			{Name: "handle", Type: proto.ColumnType_STRING, Description: "The current handle of the account, for identity events.", Transform: transform.FromField("handle")},
+			{Name: "active", Type: proto.ColumnType_BOOL, Description: "Whether the account is active, for account events.", Transform: transform.FromField("active")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Why the account is inactive, for account events. Possible values include: takendown, suspended, deleted, deactivated.", Transform: transform.FromField("status")},
+			{Name: "seq", Type: proto.ColumnType_INT, Description: "The firehose sequence number of the event, for identity and account events.", Transform: transform.FromField("seq")},
+			{Name: "collections", Type: proto.ColumnType_JSON, Description: "A JSON array of collection NSIDs to read events for, sent to Jetstream as one subscription. NSIDs may end in a wildcard, such as app.bsky.feed.*.", Transform: transform.FromField("collections")},
+			{Name: "dids", Type: proto.ColumnType_JSON, Description: "A JSON array of DIDs of the accounts to read events for, sent to Jetstream as one subscription.", Transform: transform.FromField("dids")},
+			{Name: "limit", Type: proto.ColumnType_INT, Description: "The number of events to read before stopping.", Transform: transform.FromField("limit")},
+			{Name: "duration", Type: proto.ColumnType_STRING, Description: "How long to read events for, as a duration such as 30s or 5m.", Transform: transform.FromField("duration")},
+			{Name: "cursor", Type: proto.ColumnType_INT, Description: "The time to replay events from, in microseconds since the Unix epoch.", Transform: transform.FromField("cursor")},
+			{Name: "compress", Type: proto.ColumnType_BOOL, Description: "Whether events are requested zstd compressed.", Transform: transform.FromField("compress")},
+		},
+	}
+}
+
+// jetstreamEvent is an event as sent by Jetstream.
+type jetstreamEvent struct {
+	Did    string `json:"did"`
+	TimeUS int64  `json:"time_us"`
+	Kind   string `json:"kind"`
+	Commit *struct {
+		Rev        string          `json:"rev"`
+		Operation  string          `json:"operation"`
+		Collection string          `json:"collection"`
+		Rkey       string          `json:"rkey"`
+		Record     json.RawMessage `json:"record"`
+		Cid        string          `json:"cid"`
+	} `json:"commit"`
+	Identity *struct {
+		Handle string `json:"handle"`
+		Seq    int64  `json:"seq"`
+	} `json:"identity"`
+	Account *struct {
+		Active bool   `json:"active"`
+		Status string `json:"status"`
+		Seq    int64  `json:"seq"`
+	} `json:"account"`
+}
+
+func listJetstream(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	maxEvents, duration, err := streamBounds(d)
+	if err != nil {
+		logger.Error("listJetstream: Invalid bounds", "error", err)
+		return nil, err
+	}
+
+	config, err := GetConfig(d.Connection)
+	if err != nil {
+		logger.Error("listJetstream: Failed to get config", "error", err)
+		return nil, err
+	}
+
+	// The SDK opens a stream per value of a collection or did IN list, so
+	// several values are only sent as one subscription through the array
+	// key columns
+	collections, err := qualJSONStrings(d, "collections")
+	if err != nil {
+		logger.Error("listJetstream: Invalid collections", "error", err)
+		return nil, err
+	}
+	dids, err := qualJSONStrings(d, "dids")
+	if err != nil {
+		logger.Error("listJetstream: Invalid dids", "error", err)
+		return nil, err
+	}
+
+	params := url.Values{}
+	for _, collection := range collections {
+		params.Add("wantedCollections", collection)
+	}
+	if collection := d.EqualsQualString("collection"); collection != "" {
+		// Rows would not match a wildcard, so Postgres would drop them all
+		if strings.HasSuffix(collection, "*") {
+			return nil, fmt.Errorf("collection %q is a wildcard, use collections = '[\"%s\"]' instead", collection, collection)
+		}
+		params.Add("wantedCollections", collection)
+	}
+	for _, did := range dids {
+		params.Add("wantedDids", did)
+	}
+	if did := d.EqualsQualString("did"); did != "" {
+		params.Add("wantedDids", did)
+	}
+	if d.EqualsQuals["cursor"] != nil {
+		params.Set("cursor", strconv.FormatInt(d.EqualsQuals["cursor"].GetInt64Value(), 10))
+	}
+
+	compress := d.EqualsQuals["compress"] != nil && d.EqualsQuals["compress"].GetBoolValue()
+	var decoder *zstd.Decoder
+	if compress {
+		decoder, err = jetstreamDecoder(config)
+		if err != nil {
+			logger.Error("listJetstream: Failed to create zstd decoder", "error", err)
+			return nil, err
+		}
+		defer decoder.Close()
+		params.Set("compress", "true")
+	}
+
+	streamURL := strings.TrimSuffix(*config.JetstreamHost, "/") + "/subscribe"
+	if len(params) > 0 {
+		streamURL += "?" + params.Encode()
+	}
+
+	var events int64
+	err = readStream(ctx, streamURL, duration, func(msg []byte) (bool, error) {
+		if decoder != nil {
+			decoded, err := decoder.DecodeAll(msg, nil)
+			if err != nil {
+				return false, fmt.Errorf("failed to decompress event: %w", err)
+			}
+			msg = decoded
+		}
+
+		var evt jetstreamEvent
+		if err := json.Unmarshal(msg, &evt); err != nil {
+			return false, fmt.Errorf("failed to decode event: %w", err)
+		}
+
+		item := jetstreamEventItem(&evt)
+		streamKeyColumnItem(d, item)
+		item["compress"] = compress
+		if collections != nil {
+			item["collections"] = collections
+		}
+		if dids != nil {
+			item["dids"] = dids
+		}
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return false, nil
+		}
+
+		events++
+		return maxEvents == 0 || events < maxEvents, nil
+	})
+	if err != nil {
+		logger.Error("listJetstream: Failed to read events", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// jetstreamDecoder returns a zstd decoder for compressed Jetstream events,
+// which are compressed with a custom dictionary that must be configured.
+func jetstreamDecoder(config blueskyConfig) (*zstd.Decoder, error) {
+	if config.JetstreamZstdDictionary == nil || *config.JetstreamZstdDictionary == "" {
+		return nil, fmt.Errorf("jetstream_zstd_dictionary must be set in the connection config to use compress")
+	}
+
+	path, err := expandHomeDir(*config.JetstreamZstdDictionary)
+	if err != nil {
+		return nil, err
+	}
+	dict, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zstd dictionary: %w", err)
+	}
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dict))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load zstd dictionary: %w", err)
+	}
+	return decoder, nil
+}
+
+// jetstreamEventItem builds a row from a Jetstream event.
+func jetstreamEventItem(evt *jetstreamEvent) map[string]interface{} {
+	item := map[string]interface{}{
+		"kind":    evt.Kind,
+		"did":     evt.Did,
+		"time_us": evt.TimeUS,
+		"time":    time.UnixMicro(evt.TimeUS).UTC().Format(time.RFC3339Nano),
+	}
+
+	switch {
+	case evt.Commit != nil:
+		item["rev"] = evt.Commit.Rev
+		item["operation"] = evt.Commit.Operation
+		item["collection"] = evt.Commit.Collection
+		item["rkey"] = evt.Commit.Rkey
+		item["uri"] = fmt.Sprintf("at://%s/%s/%s", evt.Did, evt.Commit.Collection, evt.Commit.Rkey)
+		item["cid"] = evt.Commit.Cid
+		if len(evt.Commit.Record) > 0 {
+			item["record"] = evt.Commit.Record
+		}
+	case evt.Identity != nil:
+		item["handle"] = evt.Identity.Handle
+		item["seq"] = evt.Identity.Seq
+	case evt.Account != nil:
+		// Set explicitly so inactive accounts return false rather than null
+		item["active"] = evt.Account.Active
+		item["status"] = evt.Account.Status
+		item["seq"] = evt.Account.Seq
+	}
+
+	return item
+}
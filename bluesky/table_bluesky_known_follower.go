@@ -0,0 +1,87 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyKnownFollower(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_known_follower",
+		Description: "List of followers of the specified user that the authenticated user also follows.",
+		List: &plugin.ListConfig{
+			Hydrate: listKnownFollower,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "target_did",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          userColumns("target_did"),
+	}
+}
+
+func listKnownFollower(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	targetDid := d.EqualsQualString("target_did")
+	if targetDid == "" {
+		logger.Error("listKnownFollower: No target_did specified")
+		return nil, fmt.Errorf("target_did must be specified")
+	}
+
+	if !strings.HasPrefix(targetDid, "did:") {
+		logger.Error("listKnownFollower: Invalid DID format", "did", targetDid)
+		return nil, fmt.Errorf("invalid DID format: %s", targetDid)
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listKnownFollower: Error connecting", "error", err)
+		return nil, err
+	}
+
+	cursor := ""
+	for {
+		out, err := bsky.GraphGetKnownFollowers(ctx, client, targetDid, cursor, 100)
+		if err != nil {
+			logger.Error("listKnownFollower: Error getting known followers", "error", err, "did", targetDid)
+			return nil, fmt.Errorf("failed to get known followers for %s: %v", targetDid, err)
+		}
+
+		items, err := profileViewItems(ctx, client, out.Followers)
+		if err != nil {
+			logger.Error("listKnownFollower: Error getting follower profiles", "error", err, "did", targetDid)
+			return nil, err
+		}
+
+		for _, item := range items {
+			item["target_did"] = targetDid
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Followers) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,156 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyLabel(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_label",
+		Description: "Moderation labels applied by labelers to Bluesky accounts and records.",
+		List: &plugin.ListConfig{
+			Hydrate: listLabel,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "uri_patterns",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "sources",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "src", Type: proto.ColumnType_STRING, Description: "The DID of the labeler that created the label.", Transform: transform.FromField("src")},
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the account or record the label applies to.", Transform: transform.FromField("uri")},
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the specific record version the label applies to, if any.", Transform: transform.FromField("cid")},
+			{Name: "val", Type: proto.ColumnType_STRING, Description: "The value of the label, e.g. spam or porn.", Transform: transform.FromField("val")},
+			{Name: "neg", Type: proto.ColumnType_BOOL, Description: "Whether the label negates an earlier label with the same value.", Transform: transform.FromField("neg")},
+			{Name: "cts", Type: proto.ColumnType_STRING, Description: "When the label was created.", Transform: transform.FromField("cts")},
+			{Name: "exp", Type: proto.ColumnType_STRING, Description: "When the label expires, if ever.", Transform: transform.FromField("exp")},
+			{Name: "ver", Type: proto.ColumnType_INT, Description: "The AT Protocol version of the label.", Transform: transform.FromField("ver")},
+			{Name: "uri_patterns", Type: proto.ColumnType_STRING, Description: "The URI or URI prefix ending in * used to find the label.", Transform: transform.FromField("uri_patterns")},
+			{Name: "sources", Type: proto.ColumnType_STRING, Description: "The DID of the labeler that was queried for the label.", Transform: transform.FromField("sources")},
+		},
+	}
+}
+
+func listLabel(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	patterns := qualStringValues(d, "uri_patterns")
+	if len(patterns) == 0 {
+		logger.Error("listLabel: No uri_patterns specified")
+		return nil, fmt.Errorf("uri_patterns must be specified")
+	}
+
+	sources := qualStringValues(d, "sources")
+	if len(sources) == 0 {
+		// Without a labeler, ask the PDS, which proxies to the AppView
+		client, err := connect(ctx, d)
+		if err != nil {
+			logger.Error("listLabel: Failed to connect", "error", err)
+			return nil, fmt.Errorf("failed to connect: %w", err)
+		}
+		for _, pattern := range patterns {
+			done, err := streamLabels(ctx, d, client, "", pattern)
+			if err != nil || done {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+
+	for _, source := range sources {
+		// Labels are queried from each labeler's own service
+		client, err := labelerClient(ctx, source)
+		if err != nil {
+			logger.Error("listLabel: Failed to resolve labeler", "error", err, "source", source)
+			return nil, err
+		}
+		for _, pattern := range patterns {
+			done, err := streamLabels(ctx, d, client, source, pattern)
+			if err != nil || done {
+				return nil, err
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// labelerClient returns an unauthenticated XRPC client for the labeler
+// service declared in the DID document of the given labeler.
+func labelerClient(ctx context.Context, did string) (*xrpc.Client, error) {
+	_, ident, err := repoClient(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	host := ident.GetServiceEndpoint("atproto_labeler")
+	if host == "" {
+		return nil, fmt.Errorf("no labeler service declared for %s", ident.DID)
+	}
+
+	return &xrpc.Client{Host: host}, nil
+}
+
+// streamLabels streams every label matching a URI pattern. It returns true
+// once no more rows are needed.
+func streamLabels(ctx context.Context, d *plugin.QueryData, client *xrpc.Client, source string, pattern string) (bool, error) {
+	logger := plugin.Logger(ctx)
+
+	var sources []string
+	if source != "" {
+		sources = []string{source}
+	}
+
+	cursor := ""
+	for {
+		out, err := atproto.LabelQueryLabels(ctx, client, cursor, 250, sources, []string{pattern})
+		if err != nil {
+			logger.Error("listLabel: Failed to query labels", "error", err, "pattern", pattern, "source", source)
+			return false, fmt.Errorf("failed to query labels for %s: %w", pattern, err)
+		}
+
+		for _, label := range out.Labels {
+			item := map[string]interface{}{
+				"src":          label.Src,
+				"uri":          label.Uri,
+				"cid":          derefString(label.Cid),
+				"val":          label.Val,
+				"neg":          label.Neg != nil && *label.Neg,
+				"cts":          label.Cts,
+				"exp":          derefString(label.Exp),
+				"ver":          derefInt64(label.Ver),
+				"uri_patterns": pattern,
+				"sources":      source,
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Labels) == 0 {
+			return false, nil
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,131 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyLabeler(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_labeler",
+		Description: "Bluesky labeler services and the labels they can apply.",
+		List: &plugin.ListConfig{
+			Hydrate: listLabeler,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "did",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the labeler.", Transform: transform.FromField("did")},
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the labeler service record.", Transform: transform.FromField("uri")},
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the labeler service record.", Transform: transform.FromField("cid")},
+			{Name: "creator_handle", Type: proto.ColumnType_STRING, Description: "The handle of the labeler account.", Transform: transform.FromField("creator_handle")},
+			{Name: "creator_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the labeler account.", Transform: transform.FromField("creator_display_name")},
+			{Name: "creator_description", Type: proto.ColumnType_STRING, Description: "The description of the labeler account.", Transform: transform.FromField("creator_description")},
+			{Name: "like_count", Type: proto.ColumnType_INT, Description: "Number of likes on the labeler.", Transform: transform.FromField("like_count")},
+			{Name: "label_values", Type: proto.ColumnType_JSON, Description: "The label values the labeler publishes, which may include global and custom labels.", Transform: transform.FromField("label_values")},
+			{Name: "label_value_definitions", Type: proto.ColumnType_JSON, Description: "Definitions of the custom label values created by the labeler, including severity, blurs, default setting and localized names.", Transform: transform.FromField("label_value_definitions")},
+			{Name: "reason_types", Type: proto.ColumnType_JSON, Description: "The report reason types the labeler reviews. Null means all reason types are accepted.", Transform: transform.FromField("reason_types")},
+			{Name: "subject_types", Type: proto.ColumnType_JSON, Description: "The subject types, such as account or record, the labeler accepts reports on.", Transform: transform.FromField("subject_types")},
+			{Name: "subject_collections", Type: proto.ColumnType_JSON, Description: "The record collections that can be reported to the labeler. Null means any collection.", Transform: transform.FromField("subject_collections")},
+			{Name: "labels", Type: proto.ColumnType_JSON, Description: "Moderation labels applied to the labeler.", Transform: transform.FromField("labels")},
+			{Name: "viewer_like", Type: proto.ColumnType_STRING, Description: "The URI of the authenticated user's like of the labeler, if any.", Transform: transform.FromField("viewer_like")},
+			{Name: "indexed_at", Type: proto.ColumnType_STRING, Description: "When the labeler was indexed.", Transform: transform.FromField("indexed_at")},
+		},
+	}
+}
+
+// labelerViewItem builds a labeler row from a detailed labeler view.
+func labelerViewItem(view *bsky.LabelerDefs_LabelerViewDetailed) map[string]interface{} {
+	item := map[string]interface{}{
+		"uri":                 view.Uri,
+		"cid":                 view.Cid,
+		"like_count":          derefInt64(view.LikeCount),
+		"labels":              labelItems(view.Labels),
+		"subject_collections": view.SubjectCollections,
+		"indexed_at":          view.IndexedAt,
+	}
+	if view.Creator != nil {
+		item["did"] = view.Creator.Did
+		item["creator_handle"] = view.Creator.Handle
+		item["creator_display_name"] = derefString(view.Creator.DisplayName)
+		item["creator_description"] = derefString(view.Creator.Description)
+	}
+	if view.Policies != nil {
+		item["label_values"] = derefStrings(view.Policies.LabelValues)
+		item["label_value_definitions"] = view.Policies.LabelValueDefinitions
+	}
+	// Keep an undefined list distinct from an empty one
+	if view.ReasonTypes != nil {
+		item["reason_types"] = derefStrings(view.ReasonTypes)
+	}
+	if view.SubjectTypes != nil {
+		item["subject_types"] = derefStrings(view.SubjectTypes)
+	}
+	if view.Viewer != nil {
+		item["viewer_like"] = derefString(view.Viewer.Like)
+	}
+	return item
+}
+
+func listLabeler(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	dids := qualStringValues(d, "did")
+	if len(dids) == 0 {
+		// Default to the labelers the connection subscribes to
+		config, err := GetConfig(d.Connection)
+		if err != nil {
+			logger.Error("listLabeler: Failed to get config", "error", err)
+			return nil, fmt.Errorf("failed to get config: %v", err)
+		}
+		dids = config.AcceptLabelers
+	}
+	if len(dids) == 0 {
+		logger.Error("listLabeler: No did specified")
+		return nil, fmt.Errorf("did must be specified when accept_labelers is not configured")
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listLabeler: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	for start := 0; start < len(dids); start += 25 {
+		end := start + 25
+		if end > len(dids) {
+			end = len(dids)
+		}
+
+		out, err := bsky.LabelerGetServices(ctx, client, true, dids[start:end])
+		if err != nil {
+			logger.Error("listLabeler: Failed to get labeler services", "error", err)
+			return nil, fmt.Errorf("failed to get labeler services: %w", err)
+		}
+
+		for _, view := range out.Views {
+			if view.LabelerDefs_LabelerViewDetailed == nil {
+				continue
+			}
+			d.StreamListItem(ctx, labelerViewItem(view.LabelerDefs_LabelerViewDetailed))
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,180 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyList(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_list",
+		Description: "Curation and moderation lists created by a Bluesky user.",
+		List: &plugin.ListConfig{
+			Hydrate: listList,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "creator_did",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "creator_handle",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "uri",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "http_url",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          listColumns(),
+	}
+}
+
+func listColumns(optionalCols ...string) []*plugin.Column {
+	cols := []*plugin.Column{
+		{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the list.", Transform: transform.FromField("uri")},
+		{Name: "http_url", Type: proto.ColumnType_STRING, Description: "The HTTP URL for the list on bsky.app.", Transform: transform.FromField("http_url")},
+		{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the list record.", Transform: transform.FromField("cid")},
+		{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the list.", Transform: transform.FromField("name")},
+		{Name: "purpose", Type: proto.ColumnType_STRING, Description: "The purpose of the list, e.g. app.bsky.graph.defs#curatelist or app.bsky.graph.defs#modlist.", Transform: transform.FromField("purpose")},
+		{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the list.", Transform: transform.FromField("description")},
+		{Name: "list_item_count", Type: proto.ColumnType_INT, Description: "Number of users on the list.", Transform: transform.FromField("list_item_count")},
+		{Name: "avatar", Type: proto.ColumnType_STRING, Description: "URL of the list's avatar image.", Transform: transform.FromField("avatar")},
+		{Name: "creator_did", Type: proto.ColumnType_STRING, Description: "The DID of the user who created the list.", Transform: transform.FromField("creator_did")},
+		{Name: "creator_handle", Type: proto.ColumnType_STRING, Description: "The handle of the user who created the list.", Transform: transform.FromField("creator_handle")},
+		{Name: "indexed_at", Type: proto.ColumnType_STRING, Description: "When the list was indexed.", Transform: transform.FromField("indexed_at")},
+		{Name: "viewer_muted", Type: proto.ColumnType_BOOL, Description: "Whether the authenticated user mutes the members of the list.", Transform: transform.FromField("viewer_muted")},
+		{Name: "viewer_blocked", Type: proto.ColumnType_STRING, Description: "The URI of the authenticated user's block of the list, if any.", Transform: transform.FromField("viewer_blocked")},
+	}
+
+	for _, col := range optionalCols {
+		switch col {
+		case "subscription":
+			cols = append(cols, &plugin.Column{
+				Name:        "subscription",
+				Type:        proto.ColumnType_STRING,
+				Description: "How the authenticated user subscribes to the list. Possible values are: block, mute.",
+				Transform:   transform.FromField("subscription"),
+			})
+		}
+	}
+	return cols
+}
+
+// listViewItem builds a row matching listColumns from a list view.
+func listViewItem(view *bsky.GraphDefs_ListView) map[string]interface{} {
+	item := map[string]interface{}{
+		"uri":             view.Uri,
+		"http_url":        convertToHttpUrl(view.Uri),
+		"cid":             view.Cid,
+		"name":            view.Name,
+		"purpose":         derefString(view.Purpose),
+		"description":     derefString(view.Description),
+		"list_item_count": derefInt64(view.ListItemCount),
+		"avatar":          derefString(view.Avatar),
+		"indexed_at":      view.IndexedAt,
+	}
+	if view.Creator != nil {
+		item["creator_did"] = view.Creator.Did
+		item["creator_handle"] = view.Creator.Handle
+	}
+	if view.Viewer != nil {
+		if view.Viewer.Muted != nil {
+			item["viewer_muted"] = *view.Viewer.Muted
+		}
+		item["viewer_blocked"] = derefString(view.Viewer.Blocked)
+	}
+	return item
+}
+
+func listList(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listList: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Look up a single list by URI or bsky.app URL
+	uri := d.EqualsQualString("uri")
+	httpUrl := d.EqualsQualString("http_url")
+	if uri == "" && httpUrl != "" {
+		uri, err = convertToAtURI(ctx, client, httpUrl)
+		if err != nil {
+			logger.Error("listList: Error converting HTTP URL to URI", "error", err, "http_url", httpUrl)
+			return nil, fmt.Errorf("failed to convert HTTP URL to URI: %w", err)
+		}
+	}
+	if uri != "" {
+		out, err := bsky.GraphGetList(ctx, client, "", 1, uri)
+		if err != nil {
+			logger.Error("listList: Failed to get list", "error", err, "uri", uri)
+			return nil, fmt.Errorf("failed to get list %s: %w", uri, err)
+		}
+		if out.List == nil {
+			return nil, nil
+		}
+		item := listViewItem(out.List)
+		if httpUrl != "" {
+			// Keep the qual value as given so the key column matches
+			item["http_url"] = httpUrl
+		}
+		d.StreamListItem(ctx, item)
+		return nil, nil
+	}
+
+	actor := d.EqualsQualString("creator_did")
+	if actor != "" && !strings.HasPrefix(actor, "did:") {
+		logger.Error("listList: Invalid DID format", "did", actor)
+		return nil, fmt.Errorf("invalid DID format: %s", actor)
+	}
+	if actor == "" {
+		actor = strings.TrimPrefix(d.EqualsQualString("creator_handle"), "@")
+	}
+	if actor == "" {
+		logger.Error("listList: No creator or list specified")
+		return nil, fmt.Errorf("one of creator_did, creator_handle, uri or http_url must be specified")
+	}
+
+	cursor := ""
+	for {
+		out, err := bsky.GraphGetLists(ctx, client, actor, cursor, 100)
+		if err != nil {
+			logger.Error("listList: Failed to get lists", "error", err, "actor", actor)
+			return nil, fmt.Errorf("failed to get lists for %s: %w", actor, err)
+		}
+
+		for _, view := range out.Lists {
+			d.StreamListItem(ctx, listViewItem(view))
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Lists) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,92 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyListFeed(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_list_feed",
+		Description: "List of recent posts by the members of a Bluesky curation list.",
+		List: &plugin.ListConfig{
+			Hydrate: listListFeed,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "list_uri",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          postColumns("list_uri", "feed_reason"),
+	}
+}
+
+func listListFeed(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listURI := d.EqualsQuals["list_uri"].GetStringValue()
+	if listURI == "" {
+		logger.Error("listListFeed: No list_uri specified")
+		return nil, fmt.Errorf("list_uri must be specified")
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listListFeed: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Accept both at:// URIs and bsky.app list URLs
+	uri, err := convertToAtURI(ctx, client, listURI)
+	if err != nil {
+		logger.Error("listListFeed: Error converting list URL to URI", "error", err, "list_uri", listURI)
+		return nil, fmt.Errorf("failed to convert list URL to URI: %w", err)
+	}
+	if !strings.Contains(uri, "/app.bsky.graph.list/") {
+		logger.Error("listListFeed: Not a list URI", "uri", uri)
+		return nil, fmt.Errorf("list_uri must refer to an app.bsky.graph.list record: %s", listURI)
+	}
+
+	cursor := ""
+	for {
+		feed, err := bsky.FeedGetListFeed(ctx, client, cursor, 100, uri)
+		if err != nil {
+			logger.Error("listListFeed: Failed to get list feed", "error", err, "uri", uri)
+			return nil, fmt.Errorf("failed to get list feed %s: %w", uri, err)
+		}
+
+		for _, feedItem := range feed.Feed {
+			item := feedViewPostItem(ctx, client, feedItem)
+			if item == nil {
+				continue
+			}
+			// Keep the qual value as given so the key column matches
+			item["list_uri"] = listURI
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if feed.Cursor == nil || *feed.Cursor == "" || len(feed.Feed) == 0 {
+			break
+		}
+		cursor = *feed.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
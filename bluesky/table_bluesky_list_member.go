@@ -0,0 +1,65 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyListMember(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_list_member",
+		Description: "List of users on a Bluesky curation or moderation list.",
+		List: &plugin.ListConfig{
+			Hydrate: listListMember,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "list_uri",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          userColumns("list_uri", "list_item_uri"),
+	}
+}
+
+func listListMember(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listURI := d.EqualsQualString("list_uri")
+	if listURI == "" {
+		logger.Error("listListMember: No list_uri specified")
+		return nil, fmt.Errorf("list_uri must be specified")
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listListMember: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Accept both at:// URIs and bsky.app list URLs
+	uri, err := convertToAtURI(ctx, client, listURI)
+	if err != nil {
+		logger.Error("listListMember: Error converting list URL to URI", "error", err, "list_uri", listURI)
+		return nil, fmt.Errorf("failed to convert list URL to URI: %w", err)
+	}
+	if !strings.Contains(uri, "/app.bsky.graph.list/") {
+		logger.Error("listListMember: Not a list URI", "uri", uri)
+		return nil, fmt.Errorf("list_uri must refer to an app.bsky.graph.list record: %s", listURI)
+	}
+
+	// Keep the qual value as given so the key column matches
+	fields := map[string]interface{}{"list_uri": listURI}
+	if err := streamListMembers(ctx, d, client, uri, fields); err != nil {
+		logger.Error("listListMember: Failed to stream list members", "error", err, "uri", uri)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,76 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyMyBlock(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_my_block",
+		Description: "List of users blocked by the authenticated user.",
+		List: &plugin.ListConfig{
+			Hydrate: listMyBlock,
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          userColumns("block_uri"),
+	}
+}
+
+func listMyBlock(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listMyBlock: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	cursor := ""
+	for {
+		out, err := bsky.GraphGetBlocks(ctx, client, cursor, 100)
+		if err != nil {
+			logger.Error("listMyBlock: Failed to get blocks", "error", err)
+			return nil, fmt.Errorf("failed to get blocks: %w", err)
+		}
+
+		blockURIs := make(map[string]string, len(out.Blocks))
+		for _, blocked := range out.Blocks {
+			if blocked != nil && blocked.Viewer != nil {
+				blockURIs[blocked.Did] = derefString(blocked.Viewer.Blocking)
+			}
+		}
+
+		items, err := profileViewItems(ctx, client, out.Blocks)
+		if err != nil {
+			logger.Error("listMyBlock: Failed to get blocked profiles", "error", err)
+			return nil, err
+		}
+
+		for _, item := range items {
+			item["block_uri"] = blockURIs[item["did"].(string)]
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Blocks) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
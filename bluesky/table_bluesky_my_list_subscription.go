@@ -0,0 +1,109 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyMyListSubscription(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_my_list_subscription",
+		Description: "Moderation lists the authenticated user subscribes to for blocking or muting.",
+		List: &plugin.ListConfig{
+			Hydrate: listMyListSubscription,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "subscription",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          listColumns("subscription"),
+	}
+}
+
+func listMyListSubscription(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	subscription := d.EqualsQualString("subscription")
+	if subscription != "" && subscription != "block" && subscription != "mute" {
+		logger.Error("listMyListSubscription: Invalid subscription", "subscription", subscription)
+		return nil, fmt.Errorf("subscription must be one of: block, mute")
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listMyListSubscription: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	for _, kind := range []string{"block", "mute"} {
+		if subscription != "" && subscription != kind {
+			continue
+		}
+
+		done, err := streamListSubscriptions(ctx, d, client, kind)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// streamListSubscriptions streams every list the authenticated user blocks or
+// mutes. It returns true once no more rows are needed.
+func streamListSubscriptions(ctx context.Context, d *plugin.QueryData, client *xrpc.Client, kind string) (bool, error) {
+	logger := plugin.Logger(ctx)
+
+	cursor := ""
+	for {
+		var lists []*bsky.GraphDefs_ListView
+		var next *string
+		if kind == "block" {
+			out, err := bsky.GraphGetListBlocks(ctx, client, cursor, 100)
+			if err != nil {
+				logger.Error("listMyListSubscription: Failed to get list blocks", "error", err)
+				return false, fmt.Errorf("failed to get list blocks: %w", err)
+			}
+			lists, next = out.Lists, out.Cursor
+		} else {
+			out, err := bsky.GraphGetListMutes(ctx, client, cursor, 100)
+			if err != nil {
+				logger.Error("listMyListSubscription: Failed to get list mutes", "error", err)
+				return false, fmt.Errorf("failed to get list mutes: %w", err)
+			}
+			lists, next = out.Lists, out.Cursor
+		}
+
+		for _, view := range lists {
+			item := listViewItem(view)
+			item["subscription"] = kind
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+
+		if next == nil || *next == "" || len(lists) == 0 {
+			return false, nil
+		}
+		cursor = *next
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,68 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyMySuggestedFollow(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_my_suggested_follow",
+		Description: "Accounts Bluesky suggests the authenticated user follow.",
+		List: &plugin.ListConfig{
+			Hydrate: listMySuggestedFollow,
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          userColumns(),
+	}
+}
+
+func listMySuggestedFollow(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listMySuggestedFollow: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	cursor := ""
+	for {
+		out, err := bsky.ActorGetSuggestions(ctx, client, cursor, 100)
+		if err != nil {
+			logger.Error("listMySuggestedFollow: Failed to get suggestions", "error", err)
+			return nil, fmt.Errorf("failed to get suggestions: %w", err)
+		}
+
+		items, err := profileViewItems(ctx, client, out.Actors)
+		if err != nil {
+			logger.Error("listMySuggestedFollow: Failed to get suggestion profiles", "error", err)
+			return nil, err
+		}
+
+		for _, item := range items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Actors) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
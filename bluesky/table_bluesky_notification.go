@@ -0,0 +1,116 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyNotification(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_notification",
+		Description: "Notifications received by the authenticated user, including likes, reposts, follows, mentions, replies and quotes.",
+		List: &plugin.ListConfig{
+			Hydrate: listNotification,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "reason",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "priority",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the record that caused the notification.", Transform: transform.FromField("uri")},
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the record that caused the notification.", Transform: transform.FromField("cid")},
+			{Name: "reason", Type: proto.ColumnType_STRING, Description: "Why the notification was sent. Possible values include: like, repost, follow, mention, reply, quote, starterpack-joined, verified, unverified.", Transform: transform.FromField("reason")},
+			{Name: "reason_subject", Type: proto.ColumnType_STRING, Description: "The URI of the subject of the notification, e.g. the post that was liked or reposted.", Transform: transform.FromField("reason_subject")},
+			{Name: "author_did", Type: proto.ColumnType_STRING, Description: "The DID of the user who caused the notification.", Transform: transform.FromField("author_did")},
+			{Name: "author_handle", Type: proto.ColumnType_STRING, Description: "The handle of the user who caused the notification.", Transform: transform.FromField("author_handle")},
+			{Name: "author_display_name", Type: proto.ColumnType_STRING, Description: "The display name of the user who caused the notification.", Transform: transform.FromField("author_display_name")},
+			{Name: "text", Type: proto.ColumnType_STRING, Description: "The text of the post, if the notification was caused by a post.", Transform: transform.FromField("text")},
+			{Name: "record", Type: proto.ColumnType_JSON, Description: "The record that caused the notification.", Transform: transform.FromField("record")},
+			{Name: "is_read", Type: proto.ColumnType_BOOL, Description: "Whether the notification has been read.", Transform: transform.FromField("is_read")},
+			{Name: "labels", Type: proto.ColumnType_JSON, Description: "Moderation labels applied to the record that caused the notification.", Transform: transform.FromField("labels")},
+			{Name: "indexed_at", Type: proto.ColumnType_STRING, Description: "When the notification was indexed.", Transform: transform.FromField("indexed_at")},
+			{Name: "seen_at", Type: proto.ColumnType_STRING, Description: "When the authenticated user last marked notifications as seen.", Transform: transform.FromField("seen_at")},
+			{Name: "priority", Type: proto.ColumnType_BOOL, Description: "Whether only priority notifications were requested.", Transform: transform.FromField("priority")},
+		},
+	}
+}
+
+func listNotification(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listNotification: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	reasons := qualStringValues(d, "reason")
+	priority := false
+	if d.EqualsQuals["priority"] != nil {
+		priority = d.EqualsQuals["priority"].GetBoolValue()
+	}
+
+	cursor := ""
+	for {
+		out, err := bsky.NotificationListNotifications(ctx, client, cursor, 100, priority, reasons, "")
+		if err != nil {
+			logger.Error("listNotification: Failed to list notifications", "error", err)
+			return nil, fmt.Errorf("failed to list notifications: %w", err)
+		}
+
+		for _, notification := range out.Notifications {
+			item := map[string]interface{}{
+				"uri":            notification.Uri,
+				"cid":            notification.Cid,
+				"reason":         notification.Reason,
+				"reason_subject": derefString(notification.ReasonSubject),
+				"record":         notification.Record,
+				"is_read":        notification.IsRead,
+				"labels":         labelItems(notification.Labels),
+				"indexed_at":     notification.IndexedAt,
+				"seen_at":        derefString(out.SeenAt),
+				"priority":       priority,
+			}
+			if notification.Author != nil {
+				item["author_did"] = notification.Author.Did
+				item["author_handle"] = notification.Author.Handle
+				item["author_display_name"] = derefString(notification.Author.DisplayName)
+			}
+			if notification.Record != nil {
+				if feedPost, ok := notification.Record.Val.(*bsky.FeedPost); ok {
+					item["text"] = feedPost.Text
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Notifications) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
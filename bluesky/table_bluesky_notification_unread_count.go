@@ -0,0 +1,60 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyNotificationUnreadCount(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_notification_unread_count",
+		Description: "The number of unread notifications for the authenticated user.",
+		List: &plugin.ListConfig{
+			Hydrate: listNotificationUnreadCount,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "priority",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "count", Type: proto.ColumnType_INT, Description: "Number of unread notifications.", Transform: transform.FromField("count")},
+			{Name: "priority", Type: proto.ColumnType_BOOL, Description: "Whether only priority notifications were counted.", Transform: transform.FromField("priority")},
+		},
+	}
+}
+
+func listNotificationUnreadCount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listNotificationUnreadCount: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	priority := false
+	if d.EqualsQuals["priority"] != nil {
+		priority = d.EqualsQuals["priority"].GetBoolValue()
+	}
+
+	out, err := bsky.NotificationGetUnreadCount(ctx, client, priority, "")
+	if err != nil {
+		logger.Error("listNotificationUnreadCount: Failed to get unread count", "error", err)
+		return nil, fmt.Errorf("failed to get unread notification count: %w", err)
+	}
+
+	d.StreamListItem(ctx, map[string]interface{}{
+		"count":    out.Count,
+		"priority": priority,
+	})
+	return nil, nil
+}
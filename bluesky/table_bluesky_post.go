@@ -7,6 +7,7 @@ import (
 
 	comatproto "github.com/bluesky-social/indigo/api/atproto"
 	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/atproto/syntax"
 	"github.com/bluesky-social/indigo/xrpc"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
@@ -33,6 +34,14 @@ func tableBlueskyPost(ctx context.Context) *plugin.Table {
 	}
 }
 
+// bskyAppCollections maps the record segment of a bsky.app profile URL to the
+// collection NSID of the record it refers to.
+var bskyAppCollections = map[string]string{
+	"post":  "app.bsky.feed.post",
+	"feed":  "app.bsky.feed.generator",
+	"lists": "app.bsky.graph.list",
+}
+
 // convertToAtURI converts a web URL to an at-uri format
 func convertToAtURI(ctx context.Context, client *xrpc.Client, uri string) (string, error) {
 
@@ -65,20 +74,28 @@ func convertToAtURI(ctx context.Context, client *xrpc.Client, uri string) (strin
 			}
 		}
 
-		// Find the profile and post segments
-		var identifier, postID string
+		// Find the profile and record segments, e.g. /profile/<id>/post/<rkey>,
+		// /profile/<id>/feed/<rkey> or /profile/<id>/lists/<rkey>
+		var identifier, collection, recordID string
 		for i := 0; i < len(parts)-1; i++ {
 
 			if parts[i] == "profile" && i+1 < len(parts) {
 				identifier = parts[i+1]
 			}
-			if parts[i] == "post" && i+1 < len(parts) {
-				postID = parts[i+1]
+			if nsid, ok := bskyAppCollections[parts[i]]; ok && identifier != "" && i+1 < len(parts) {
+				collection = nsid
+				recordID = parts[i+1]
+			}
+			// Starter packs have no profile segment: /starter-pack/<id>/<rkey>
+			if parts[i] == "starter-pack" && i+2 < len(parts) {
+				identifier = parts[i+1]
+				collection = "app.bsky.graph.starterpack"
+				recordID = parts[i+2]
 			}
 		}
 
-		if identifier == "" || postID == "" {
-			return "", fmt.Errorf("invalid bsky.app URL format: could not find identifier or post ID")
+		if identifier == "" || recordID == "" {
+			return "", fmt.Errorf("invalid bsky.app URL format: could not find identifier or record ID")
 		}
 
 		// Clean up the identifier
@@ -86,7 +103,7 @@ func convertToAtURI(ctx context.Context, client *xrpc.Client, uri string) (strin
 
 		// If the identifier is already a DID, use it directly
 		if strings.HasPrefix(identifier, "did:") {
-			atURI := fmt.Sprintf("at://%s/app.bsky.feed.post/%s", identifier, postID)
+			atURI := fmt.Sprintf("at://%s/%s/%s", identifier, collection, recordID)
 			return atURI, nil
 		}
 
@@ -96,7 +113,7 @@ func convertToAtURI(ctx context.Context, client *xrpc.Client, uri string) (strin
 			return "", fmt.Errorf("failed to resolve identifier '%s' to DID: %v", identifier, err)
 		}
 
-		atURI := fmt.Sprintf("at://%s/app.bsky.feed.post/%s", didResp.Did, postID)
+		atURI := fmt.Sprintf("at://%s/%s/%s", didResp.Did, collection, recordID)
 		return atURI, nil
 	}
 
@@ -129,6 +146,18 @@ func listPost(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 		return nil, fmt.Errorf("either uri or http_url must be specified")
 	}
 
+	// convertToAtURI also accepts feed, list and starter pack URLs, which
+	// getPostThread would reject with an unhelpful error
+	aturi, err := syntax.ParseATURI(uri)
+	if err != nil {
+		logger.Error("listPost: Invalid URI", "error", err, "uri", uri)
+		return nil, fmt.Errorf("invalid post URI %s: %w", uri, err)
+	}
+	if aturi.Collection().String() != "app.bsky.feed.post" {
+		logger.Error("listPost: URI is not a post", "uri", uri)
+		return nil, fmt.Errorf("%s is not a post, it is in the %s collection", uri, aturi.Collection())
+	}
+
 	thread, err := bsky.FeedGetPostThread(ctx, conn, 0, 0, uri)
 	if err != nil {
 		logger.Error("listPost: Error getting thread", "error", err)
@@ -140,37 +169,12 @@ func listPost(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 		return nil, nil
 	}
 
-	post := thread.Thread.FeedDefs_ThreadViewPost.Post
-	feedPost, ok := post.Record.Val.(*bsky.FeedPost)
-	if !ok {
+	item := postViewItem(ctx, conn, thread.Thread.FeedDefs_ThreadViewPost.Post)
+	if item == nil {
 		logger.Error("listPost: Could not convert to FeedPost")
 		return nil, nil
 	}
 
-	metadata := extractPostMetadata(feedPost)
-	mentionedDIDs := metadata["mentioned_handles"].([]string)
-	mentionedHandles := resolveDIDsToHandles(ctx, conn, mentionedDIDs)
-
-	item := map[string]interface{}{
-		"uri":                     post.Uri,
-		"http_url":                convertToHttpUrl(post.Uri),
-		"cid":                     post.Cid,
-		"author":                  post.Author.Handle,
-		"text":                    feedPost.Text,
-		"reply_root":              getReplyRoot(feedPost),
-		"reply_parent":            getReplyParent(feedPost),
-		"created_at":              feedPost.CreatedAt,
-		"indexed_at":              post.IndexedAt,
-		"like_count":              post.LikeCount,
-		"repost_count":            post.RepostCount,
-		"has_external_links":      metadata["has_external_links"],
-		"image_count":             metadata["image_count"],
-		"hashtags":                metadata["hashtags"],
-		"mentioned_handles":       metadata["mentioned_handles"],
-		"mentioned_handles_names": mentionedHandles,
-		"external_links":          metadata["external_links"],
-	}
-
 	d.StreamListItem(ctx, item)
 	return nil, nil
 }
@@ -228,13 +232,10 @@ func extractPostMetadata(post *bsky.FeedPost) map[string]interface{} {
 	metadata["external_links"] = externalLinks
 	metadata["has_external_links"] = len(externalLinks) > 0
 
-	// Count images
+	// Count images, including those alongside a quoted record
 	imageCount := 0
-	if post.Embed != nil {
-		// Access embed data directly
-		if post.Embed.EmbedImages != nil {
-			imageCount = len(post.Embed.EmbedImages.Images)
-		}
+	if images, _, _ := postMedia(post); images != nil {
+		imageCount = len(images.Images)
 	}
 	metadata["image_count"] = imageCount
 
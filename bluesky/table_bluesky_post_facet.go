@@ -0,0 +1,134 @@
+package bluesky
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyPostFacet(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_post_facet",
+		Description: "Rich text facets of posts, one row per mention, link or tag, with the text span each covers.",
+		List: &plugin.ListConfig{
+			Hydrate: listPostFacet,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "post_uri",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "post_uri", Type: proto.ColumnType_STRING, Description: "The URI of the post.", Transform: transform.FromField("post_uri")},
+			{Name: "author_did", Type: proto.ColumnType_STRING, Description: "The DID of the post author.", Transform: transform.FromField("author_did")},
+			{Name: "facet_index", Type: proto.ColumnType_INT, Description: "The position of the facet in the post, starting at 0.", Transform: transform.FromField("facet_index")},
+			{Name: "feature_index", Type: proto.ColumnType_INT, Description: "The position of the feature in the facet, starting at 0.", Transform: transform.FromField("feature_index")},
+			{Name: "feature_type", Type: proto.ColumnType_STRING, Description: "The kind of feature. Possible values are: mention, link, tag.", Transform: transform.FromField("feature_type")},
+			{Name: "value", Type: proto.ColumnType_STRING, Description: "The DID of a mention, the URI of a link, or the tag of a tag, without the leading #.", Transform: transform.FromField("value")},
+			{Name: "byte_start", Type: proto.ColumnType_INT, Description: "The start of the text span, as a UTF-8 byte offset, inclusive.", Transform: transform.FromField("byte_start")},
+			{Name: "byte_end", Type: proto.ColumnType_INT, Description: "The end of the text span, as a UTF-8 byte offset, exclusive.", Transform: transform.FromField("byte_end")},
+			{Name: "text", Type: proto.ColumnType_STRING, Description: "The text span the facet covers, such as the anchor text of a link. Null if the offsets are outside the post text.", Transform: transform.FromField("text")},
+			{Name: "is_valid", Type: proto.ColumnType_BOOL, Description: "Whether the byte offsets form a non-empty span within the post text that starts and ends on character boundaries.", Transform: transform.FromField("is_valid")},
+			{Name: "post_created_at", Type: proto.ColumnType_STRING, Description: "When the post was created.", Transform: transform.FromField("post_created_at")},
+			{Name: "repo", Type: proto.ColumnType_STRING, Description: "The DID or handle of the repository the posts were read from.", Transform: transform.FromField("repo")},
+		},
+	}
+}
+
+func listPostFacet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	if err := streamPostChildItems(ctx, d, postFacetItems); err != nil {
+		logger.Error("listPostFacet: Failed to list post facets", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// postFacetItems builds a bluesky_post_facet row for every feature of every
+// facet in a post.
+func postFacetItems(uri string, did string, post *bsky.FeedPost) []map[string]interface{} {
+	items := []map[string]interface{}{}
+
+	for i, facet := range post.Facets {
+		if facet == nil {
+			continue
+		}
+
+		var start, end int64
+		if facet.Index != nil {
+			start, end = facet.Index.ByteStart, facet.Index.ByteEnd
+		}
+		text, valid := facetText(post.Text, start, end)
+
+		for j, feature := range facet.Features {
+			if feature == nil {
+				continue
+			}
+			item := map[string]interface{}{
+				"post_uri":        uri,
+				"author_did":      did,
+				"facet_index":     i,
+				"feature_index":   j,
+				"byte_start":      start,
+				"byte_end":        end,
+				"is_valid":        valid && facet.Index != nil,
+				"post_created_at": post.CreatedAt,
+			}
+			if text != nil {
+				item["text"] = *text
+			}
+
+			switch {
+			case feature.RichtextFacet_Mention != nil:
+				item["feature_type"] = "mention"
+				item["value"] = feature.RichtextFacet_Mention.Did
+			case feature.RichtextFacet_Link != nil:
+				item["feature_type"] = "link"
+				item["value"] = feature.RichtextFacet_Link.Uri
+			case feature.RichtextFacet_Tag != nil:
+				item["feature_type"] = "tag"
+				item["value"] = feature.RichtextFacet_Tag.Tag
+			default:
+				// Features of types unknown to this plugin are skipped
+				continue
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// facetText slices the span of a facet out of the post text. Facet offsets
+// count UTF-8 bytes, which Go strings index directly. It returns nil if the
+// offsets fall outside the text, and whether the span is non-empty and starts
+// and ends on character boundaries.
+func facetText(text string, start int64, end int64) (*string, bool) {
+	if start < 0 || end < start || end > int64(len(text)) {
+		return nil, false
+	}
+
+	span := text[start:end]
+	valid := start < end &&
+		utf8.RuneStart(text[start]) &&
+		(end == int64(len(text)) || utf8.RuneStart(text[end])) &&
+		utf8.ValidString(span)
+
+	// Replace partial characters so the span is always valid text
+	span = strings.ToValidUTF8(span, "\uFFFD")
+	return &span, valid
+}
@@ -0,0 +1,239 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyPostMedia(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_post_media",
+		Description: "Images and videos embedded in posts, including alt text.",
+		List: &plugin.ListConfig{
+			Hydrate: listPostMedia,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "post_uri",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "post_uri", Type: proto.ColumnType_STRING, Description: "The URI of the post embedding the media.", Transform: transform.FromField("post_uri")},
+			{Name: "author_did", Type: proto.ColumnType_STRING, Description: "The DID of the post author.", Transform: transform.FromField("author_did")},
+			{Name: "position", Type: proto.ColumnType_INT, Description: "The position of the media in the post, starting at 0.", Transform: transform.FromField("position")},
+			{Name: "media_type", Type: proto.ColumnType_STRING, Description: "The kind of media. Possible values are: image, video.", Transform: transform.FromField("media_type")},
+			{Name: "alt", Type: proto.ColumnType_STRING, Description: "The alt text of the media.", Transform: transform.FromField("alt")},
+			{Name: "has_alt", Type: proto.ColumnType_BOOL, Description: "Whether the media has non-blank alt text.", Transform: transform.FromField("has_alt")},
+			{Name: "aspect_ratio", Type: proto.ColumnType_JSON, Description: "The width and height of the media, if set by the author.", Transform: transform.FromField("aspect_ratio")},
+			{Name: "blob_cid", Type: proto.ColumnType_STRING, Description: "The CID of the media blob.", Transform: transform.FromField("blob_cid")},
+			{Name: "mime_type", Type: proto.ColumnType_STRING, Description: "The MIME type of the media blob.", Transform: transform.FromField("mime_type")},
+			{Name: "size", Type: proto.ColumnType_INT, Description: "The size of the media blob in bytes.", Transform: transform.FromField("size")},
+			{Name: "url", Type: proto.ColumnType_STRING, Description: "The CDN URL of the full size image, or of the video playlist.", Transform: transform.FromField("url")},
+			{Name: "thumbnail_url", Type: proto.ColumnType_STRING, Description: "The CDN URL of the image or video thumbnail.", Transform: transform.FromField("thumbnail_url")},
+			{Name: "post_created_at", Type: proto.ColumnType_STRING, Description: "When the post was created.", Transform: transform.FromField("post_created_at")},
+			{Name: "repo", Type: proto.ColumnType_STRING, Description: "The DID or handle of the repository the posts were read from.", Transform: transform.FromField("repo")},
+		},
+	}
+}
+
+// postMediaItems builds a bluesky_post_media row for every image and video
+// embedded in a post.
+func postMediaItems(uri string, did string, post *bsky.FeedPost) []map[string]interface{} {
+	items := []map[string]interface{}{}
+	images, video, _ := postMedia(post)
+
+	if images != nil {
+		for _, image := range images.Images {
+			if image == nil {
+				continue
+			}
+			item := map[string]interface{}{
+				"media_type": "image",
+				"alt":        image.Alt,
+				"has_alt":    strings.TrimSpace(image.Alt) != "",
+			}
+			if image.AspectRatio != nil {
+				item["aspect_ratio"] = image.AspectRatio
+			}
+			if image.Image != nil {
+				item["blob_cid"] = image.Image.Ref.String()
+				item["mime_type"] = image.Image.MimeType
+				item["size"] = image.Image.Size
+				item["url"] = cdnImageURL("feed_fullsize", did, image.Image)
+				item["thumbnail_url"] = cdnImageURL("feed_thumbnail", did, image.Image)
+			}
+			items = append(items, item)
+		}
+	}
+
+	if video != nil {
+		alt := derefString(video.Alt)
+		item := map[string]interface{}{
+			"media_type": "video",
+			"alt":        alt,
+			"has_alt":    strings.TrimSpace(alt) != "",
+		}
+		if video.AspectRatio != nil {
+			item["aspect_ratio"] = video.AspectRatio
+		}
+		if video.Video != nil {
+			c := video.Video.Ref.String()
+			item["blob_cid"] = c
+			item["mime_type"] = video.Video.MimeType
+			item["size"] = video.Video.Size
+			item["url"] = fmt.Sprintf("https://video.bsky.app/watch/%s/%s/playlist.m3u8", did, c)
+			item["thumbnail_url"] = fmt.Sprintf("https://video.bsky.app/watch/%s/%s/thumbnail.jpg", did, c)
+		}
+		items = append(items, item)
+	}
+
+	for i, item := range items {
+		item["post_uri"] = uri
+		item["author_did"] = did
+		item["position"] = i
+		item["post_created_at"] = post.CreatedAt
+	}
+	return items
+}
+
+func listPostMedia(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	if err := streamPostChildItems(ctx, d, postMediaItems); err != nil {
+		logger.Error("listPostMedia: Failed to list post media", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamPostChildItems streams the rows built by itemsFn for each post given
+// in the post_uri qual, or for every post in each repo given in the repo qual.
+// It backs the tables with one row per part of a post.
+func streamPostChildItems(ctx context.Context, d *plugin.QueryData, itemsFn func(uri string, did string, post *bsky.FeedPost) []map[string]interface{}) error {
+	uris := qualStringValues(d, "post_uri")
+	repos := qualStringValues(d, "repo")
+	if len(uris) == 0 && len(repos) == 0 {
+		return fmt.Errorf("post_uri or repo must be specified")
+	}
+
+	if len(uris) > 0 {
+		return streamPostChildItemsByURI(ctx, d, uris, itemsFn)
+	}
+	for _, repo := range repos {
+		done, err := streamPostChildItemsByRepo(ctx, d, repo, itemsFn)
+		if err != nil || done {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamPostChildItemsByURI streams the rows of the given posts, fetched in
+// batches with getPosts.
+func streamPostChildItemsByURI(ctx context.Context, d *plugin.QueryData, uris []string, itemsFn func(uri string, did string, post *bsky.FeedPost) []map[string]interface{}) error {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("streamPostChildItemsByURI: Connection error", "error", err)
+		return err
+	}
+
+	// getPosts accepts at most 25 URIs per call
+	for start := 0; start < len(uris); start += 25 {
+		end := min(start+25, len(uris))
+		out, err := bsky.FeedGetPosts(ctx, client, uris[start:end])
+		if err != nil {
+			logger.Error("streamPostChildItemsByURI: Failed to get posts", "error", err)
+			return fmt.Errorf("failed to get posts: %w", err)
+		}
+
+		for _, view := range out.Posts {
+			if view.Record == nil || view.Author == nil {
+				continue
+			}
+			post, ok := view.Record.Val.(*bsky.FeedPost)
+			if !ok {
+				continue
+			}
+
+			for _, item := range itemsFn(view.Uri, view.Author.Did, post) {
+				d.StreamListItem(ctx, item)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// streamPostChildItemsByRepo streams the rows of every post in a repository,
+// read from the repository's own PDS. It reports whether the query is done.
+func streamPostChildItemsByRepo(ctx context.Context, d *plugin.QueryData, repo string, itemsFn func(uri string, did string, post *bsky.FeedPost) []map[string]interface{}) (bool, error) {
+	logger := plugin.Logger(ctx)
+
+	client, ident, err := repoClient(ctx, repo)
+	if err != nil {
+		logger.Error("streamPostChildItemsByRepo: Failed to resolve repo", "error", err, "repo", repo)
+		return false, err
+	}
+	did := ident.DID.String()
+
+	cursor := ""
+	for {
+		out, err := listRepoRecords(ctx, client, did, "app.bsky.feed.post", cursor, 100, false)
+		if err != nil {
+			logger.Error("streamPostChildItemsByRepo: Failed to list posts", "error", err, "repo", repo)
+			return false, fmt.Errorf("failed to list posts for %s: %w", repo, err)
+		}
+
+		for _, record := range out.Records {
+			var post bsky.FeedPost
+			if err := json.Unmarshal(record.Value, &post); err != nil {
+				logger.Warn("streamPostChildItemsByRepo: Skipping undecodable post", "error", err, "uri", record.Uri)
+				continue
+			}
+
+			for _, item := range itemsFn(record.Uri, did, &post) {
+				// Keep the qual value as given so the key column matches
+				item["repo"] = repo
+				d.StreamListItem(ctx, item)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return true, nil
+				}
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Records) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return false, nil
+}
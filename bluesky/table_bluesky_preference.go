@@ -0,0 +1,152 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyPreference(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_preference",
+		Description: "Saved preferences of the authenticated Bluesky user, one row per setting.",
+		List: &plugin.ListConfig{
+			Hydrate: listPreference,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of preference, e.g. adultContentPref, contentLabelPref, savedFeedsPrefV2 or mutedWordsPref.", Transform: transform.FromField("type")},
+			{Name: "key", Type: proto.ColumnType_STRING, Description: "The setting within the preference, such as a field name, label, feed, muted word or post URI.", Transform: transform.FromField("key")},
+			{Name: "value", Type: proto.ColumnType_JSON, Description: "The value of the setting.", Transform: transform.FromField("value")},
+			{Name: "targets", Type: proto.ColumnType_JSON, Description: "For muted words, where the word is muted. Possible values are: content, tag.", Transform: transform.FromField("targets")},
+			{Name: "actor_target", Type: proto.ColumnType_STRING, Description: "For muted words, which accounts the mute applies to. Possible values are: all, exclude-following.", Transform: transform.FromField("actor_target")},
+			{Name: "expires_at", Type: proto.ColumnType_STRING, Description: "For muted words, when the mute expires.", Transform: transform.FromField("expires_at")},
+		},
+	}
+}
+
+// preferencesOutput is the output of app.bsky.actor.getPreferences. The
+// preferences are kept as raw JSON since the generated client drops union
+// members it doesn't know.
+type preferencesOutput struct {
+	Preferences []json.RawMessage `json:"preferences"`
+}
+
+func listPreference(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listPreference: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	var out preferencesOutput
+	if err := client.Do(ctx, xrpc.Query, "", "app.bsky.actor.getPreferences", nil, nil, &out); err != nil {
+		logger.Error("listPreference: Failed to get preferences", "error", err)
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+
+	prefType := d.EqualsQualString("type")
+	for _, raw := range out.Preferences {
+		var pref map[string]interface{}
+		if err := json.Unmarshal(raw, &pref); err != nil {
+			logger.Warn("listPreference: Skipping undecodable preference", "error", err)
+			continue
+		}
+
+		for _, item := range preferenceItems(pref) {
+			if prefType != "" && item["type"] != prefType {
+				continue
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// preferenceItems flattens a preference union member into rows. Preferences
+// holding a list get one row per list entry, and all others one row per
+// field.
+func preferenceItems(pref map[string]interface{}) []map[string]interface{} {
+	prefType, _ := pref["$type"].(string)
+	prefType = strings.TrimPrefix(prefType, "app.bsky.actor.defs#")
+	delete(pref, "$type")
+
+	var items []map[string]interface{}
+	listItems := func(field string, keyField string) {
+		entries, _ := pref[field].([]interface{})
+		for _, entry := range entries {
+			item := map[string]interface{}{
+				"type":  prefType,
+				"value": entry,
+			}
+			if obj, ok := entry.(map[string]interface{}); ok {
+				item["key"] = obj[keyField]
+			} else {
+				item["key"] = entry
+			}
+			items = append(items, item)
+		}
+	}
+
+	switch prefType {
+	case "contentLabelPref":
+		return []map[string]interface{}{{"type": prefType, "key": pref["label"], "value": pref}}
+	case "feedViewPref":
+		return []map[string]interface{}{{"type": prefType, "key": pref["feed"], "value": pref}}
+	case "savedFeedsPrefV2":
+		listItems("items", "id")
+		return items
+	case "hiddenPostsPref":
+		listItems("items", "")
+		return items
+	case "labelersPref":
+		listItems("labelers", "did")
+		return items
+	case "mutedWordsPref":
+		listItems("items", "value")
+		for _, item := range items {
+			if word, ok := item["value"].(map[string]interface{}); ok {
+				item["targets"] = word["targets"]
+				item["actor_target"] = word["actorTarget"]
+				item["expires_at"] = word["expiresAt"]
+			}
+		}
+		return items
+	}
+
+	keys := make([]string, 0, len(pref))
+	for key := range pref {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		items = append(items, map[string]interface{}{
+			"type":  prefType,
+			"key":   key,
+			"value": pref[key],
+		})
+	}
+	return items
+}
@@ -0,0 +1,164 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyRelationship(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_relationship",
+		Description: "Follow and block relationships between one Bluesky user and a set of other users.",
+		List: &plugin.ListConfig{
+			Hydrate: listRelationship,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "actor",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "other",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "others",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "actor", Type: proto.ColumnType_STRING, Description: "The DID or handle of the user the relationships are described from.", Transform: transform.FromField("actor")},
+			{Name: "other", Type: proto.ColumnType_STRING, Description: "The DID or handle of the other user.", Transform: transform.FromField("other")},
+			{Name: "others", Type: proto.ColumnType_JSON, Description: "A JSON array of DIDs or handles of other users, fetched in batches of 30 per request.", Transform: transform.FromField("others")},
+			{Name: "other_did", Type: proto.ColumnType_STRING, Description: "The DID of the other user.", Transform: transform.FromField("other_did")},
+			{Name: "following", Type: proto.ColumnType_STRING, Description: "The URI of the actor's follow record for the other user, if the actor follows them.", Transform: transform.FromField("following")},
+			{Name: "followed_by", Type: proto.ColumnType_STRING, Description: "The URI of the other user's follow record for the actor, if the other user follows the actor.", Transform: transform.FromField("followed_by")},
+			{Name: "is_mutual", Type: proto.ColumnType_BOOL, Description: "Whether the actor and the other user follow each other.", Transform: transform.FromField("is_mutual")},
+			{Name: "blocking", Type: proto.ColumnType_STRING, Description: "The URI of the actor's block record for the other user, if the actor blocks them.", Transform: transform.FromField("blocking")},
+			{Name: "blocked_by", Type: proto.ColumnType_STRING, Description: "The URI of the other user's block record for the actor, if the other user blocks the actor.", Transform: transform.FromField("blocked_by")},
+			{Name: "blocking_by_list", Type: proto.ColumnType_STRING, Description: "The URI of the moderation list through which the actor blocks the other user, if any.", Transform: transform.FromField("blocking_by_list")},
+			{Name: "blocked_by_list", Type: proto.ColumnType_STRING, Description: "The URI of the moderation list through which the other user blocks the actor, if any.", Transform: transform.FromField("blocked_by_list")},
+			{Name: "not_found", Type: proto.ColumnType_BOOL, Description: "Whether the other user could not be found.", Transform: transform.FromField("not_found")},
+		},
+	}
+}
+
+// relationship is an element of the app.bsky.graph.getRelationships output.
+// It is decoded here rather than with the generated client, which predates
+// the block fields and drops them.
+type relationship struct {
+	Type           string  `json:"$type"`
+	Did            string  `json:"did"`
+	Actor          string  `json:"actor"`
+	NotFound       bool    `json:"notFound"`
+	Following      *string `json:"following,omitempty"`
+	FollowedBy     *string `json:"followedBy,omitempty"`
+	Blocking       *string `json:"blocking,omitempty"`
+	BlockedBy      *string `json:"blockedBy,omitempty"`
+	BlockingByList *string `json:"blockingByList,omitempty"`
+	BlockedByList  *string `json:"blockedByList,omitempty"`
+}
+
+type relationshipsOutput struct {
+	Actor         *string         `json:"actor,omitempty"`
+	Relationships []*relationship `json:"relationships"`
+}
+
+func listRelationship(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	actor := strings.TrimPrefix(d.EqualsQualString("actor"), "@")
+	if actor == "" {
+		logger.Error("listRelationship: No actor specified")
+		return nil, fmt.Errorf("actor must be specified")
+	}
+
+	// The SDK makes one list call per value of an other IN list, so many
+	// accounts are only batched together when given as an others array
+	othersQual, err := qualJSONStrings(d, "others")
+	if err != nil {
+		logger.Error("listRelationship: Invalid others", "error", err)
+		return nil, err
+	}
+	others := append([]string{}, othersQual...)
+	if other := d.EqualsQualString("other"); other != "" {
+		others = append(others, other)
+	}
+	if len(others) == 0 {
+		logger.Error("listRelationship: No other specified")
+		return nil, fmt.Errorf("other or others must be specified")
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listRelationship: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// getRelationships accepts at most 30 other users per request
+	for start := 0; start < len(others); start += 30 {
+		end := start + 30
+		if end > len(others) {
+			end = len(others)
+		}
+		batch := others[start:end]
+
+		requested := make([]string, len(batch))
+		for i, other := range batch {
+			requested[i] = strings.TrimPrefix(other, "@")
+		}
+
+		var out relationshipsOutput
+		params := map[string]interface{}{
+			"actor":  actor,
+			"others": requested,
+		}
+		if err := client.Do(ctx, xrpc.Query, "", "app.bsky.graph.getRelationships", params, nil, &out); err != nil {
+			logger.Error("listRelationship: Failed to get relationships", "error", err, "actor", actor)
+			return nil, fmt.Errorf("failed to get relationships for %s: %w", actor, err)
+		}
+
+		for i, rel := range out.Relationships {
+			// Relationships are returned in the order the other users were
+			// requested, which maps each one back to its qual value
+			other := rel.Did
+			if rel.NotFound {
+				other = rel.Actor
+			}
+			if len(out.Relationships) == len(batch) {
+				other = batch[i]
+			}
+
+			item := map[string]interface{}{
+				"actor":            d.EqualsQualString("actor"),
+				"other":            other,
+				"others":           othersQual,
+				"other_did":        rel.Did,
+				"following":        derefString(rel.Following),
+				"followed_by":      derefString(rel.FollowedBy),
+				"is_mutual":        rel.Following != nil && rel.FollowedBy != nil,
+				"blocking":         derefString(rel.Blocking),
+				"blocked_by":       derefString(rel.BlockedBy),
+				"blocking_by_list": derefString(rel.BlockingByList),
+				"blocked_by_list":  derefString(rel.BlockedByList),
+				"not_found":        rel.NotFound,
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,205 @@
+package bluesky
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyRepoBlob(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_repo_blob",
+		Description: "Blobs such as images and videos stored for an atproto repository, or missing from the authenticated account's PDS.",
+		List: &plugin.ListConfig{
+			Hydrate: listRepoBlob,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "repo",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "missing",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "since",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the blob.", Transform: transform.FromField("cid")},
+			{Name: "record_uri", Type: proto.ColumnType_STRING, Description: "The URI of a record referencing the blob, for missing blobs.", Transform: transform.FromField("record_uri")},
+			{Name: "repo", Type: proto.ColumnType_STRING, Description: "The DID or handle of the repository.", Transform: transform.FromField("repo")},
+			{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the repository.", Transform: transform.FromField("did")},
+			{Name: "missing", Type: proto.ColumnType_BOOL, Description: "Whether to list blobs referenced by records but missing from the authenticated account's PDS.", Transform: transform.FromField("missing")},
+			{Name: "since", Type: proto.ColumnType_STRING, Description: "Only list blobs added since this revision of the repository.", Transform: transform.FromField("since")},
+			{Name: "size", Type: proto.ColumnType_INT, Description: "The size of the blob in bytes. Fetches the blob, so only select it when needed.", Hydrate: getRepoBlobContent, Transform: transform.FromField("Size")},
+			{Name: "mime_type", Type: proto.ColumnType_STRING, Description: "The MIME type detected from the blob content. Fetches the blob, so only select it when needed.", Hydrate: getRepoBlobContent, Transform: transform.FromField("MimeType")},
+			{Name: "sha256", Type: proto.ColumnType_STRING, Description: "The hex encoded SHA-256 digest of the blob. Fetches the blob, so only select it when needed.", Hydrate: getRepoBlobContent, Transform: transform.FromField("Sha256")},
+		},
+	}
+}
+
+// repoBlobContent holds the details of a blob computed from its content.
+type repoBlobContent struct {
+	Size     int
+	MimeType string
+	Sha256   string
+}
+
+func listRepoBlob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	repo := strings.TrimPrefix(d.EqualsQualString("repo"), "@")
+	missing := d.EqualsQuals["missing"] != nil && d.EqualsQuals["missing"].GetBoolValue()
+	if missing {
+		return listRepoMissingBlob(ctx, d, repo)
+	}
+	if repo == "" {
+		logger.Error("listRepoBlob: No repo specified")
+		return nil, fmt.Errorf("repo must be specified unless missing = true")
+	}
+
+	// Blobs are listed by the repo's own PDS, so no authentication is needed
+	client, ident, err := repoClient(ctx, repo)
+	if err != nil {
+		logger.Error("listRepoBlob: Failed to resolve repo", "error", err, "repo", repo)
+		return nil, err
+	}
+
+	since := d.EqualsQualString("since")
+	cursor := ""
+	for {
+		out, err := atproto.SyncListBlobs(ctx, client, cursor, ident.DID.String(), 1000, since)
+		if err != nil {
+			logger.Error("listRepoBlob: Failed to list blobs", "error", err, "repo", repo)
+			return nil, fmt.Errorf("failed to list blobs for %s: %w", repo, err)
+		}
+
+		for _, c := range out.Cids {
+			item := map[string]interface{}{
+				"cid": c,
+				// Keep the qual value as given so the key column matches
+				"repo":    d.EqualsQualString("repo"),
+				"did":     ident.DID.String(),
+				"missing": false,
+				"since":   since,
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Cids) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
+
+// listRepoMissingBlob lists the blobs referenced by records of the
+// authenticated account that its PDS doesn't have, such as after a migration.
+func listRepoMissingBlob(ctx context.Context, d *plugin.QueryData, repo string) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listRepoMissingBlob: Failed to connect", "error", err)
+		return nil, err
+	}
+
+	if repo != "" && repo != client.Auth.Did && repo != client.Auth.Handle {
+		return nil, fmt.Errorf("missing blobs can only be listed for the authenticated account")
+	}
+
+	cursor := ""
+	for {
+		out, err := atproto.RepoListMissingBlobs(ctx, client, cursor, 1000)
+		if err != nil {
+			logger.Error("listRepoMissingBlob: Failed to list missing blobs", "error", err)
+			return nil, fmt.Errorf("failed to list missing blobs: %w", err)
+		}
+
+		for _, blob := range out.Blobs {
+			item := map[string]interface{}{
+				"cid":        blob.Cid,
+				"record_uri": blob.RecordUri,
+				// Keep the qual value as given so the key column matches
+				"repo":    d.EqualsQualString("repo"),
+				"did":     client.Auth.Did,
+				"missing": true,
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Blobs) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
+
+// getRepoBlobContent fetches a blob with sync.getBlob to compute its size,
+// MIME type and digest. Blobs the PDS doesn't have return nil.
+func getRepoBlobContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	item := h.Item.(map[string]interface{})
+	did := item["did"].(string)
+	c := item["cid"].(string)
+
+	client, _, err := repoClient(ctx, did)
+	if err != nil {
+		logger.Error("getRepoBlobContent: Failed to resolve repo", "error", err, "did", did)
+		return nil, err
+	}
+
+	blob, err := atproto.SyncGetBlob(ctx, client, c, did)
+	if err != nil {
+		var xe *xrpc.Error
+		if errors.As(err, &xe) && (xe.StatusCode == 400 || xe.StatusCode == 404) {
+			return nil, nil
+		}
+		logger.Error("getRepoBlobContent: Failed to get blob", "error", err, "cid", c)
+		return nil, fmt.Errorf("failed to get blob %s: %w", c, err)
+	}
+
+	sum := sha256.Sum256(blob)
+	return &repoBlobContent{
+		Size:     len(blob),
+		MimeType: http.DetectContentType(blob),
+		Sha256:   hex.EncodeToString(sum[:]),
+	}, nil
+}
@@ -0,0 +1,120 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyRepoCollection(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_repo_collection",
+		Description: "Collections present in an atproto repository, along with the repository's identity.",
+		List: &plugin.ListConfig{
+			Hydrate: listRepoCollection,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "repo",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "collection", Type: proto.ColumnType_STRING, Description: "The NSID of a collection holding at least one record.", Transform: transform.FromField("collection")},
+			{Name: "repo", Type: proto.ColumnType_STRING, Description: "The DID or handle of the repository.", Transform: transform.FromField("repo")},
+			{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the repository.", Transform: transform.FromField("did")},
+			{Name: "handle", Type: proto.ColumnType_STRING, Description: "The handle of the repository.", Transform: transform.FromField("handle")},
+			{Name: "handle_is_correct", Type: proto.ColumnType_BOOL, Description: "Whether the handle currently resolves to the DID and back.", Transform: transform.FromField("handle_is_correct")},
+			{Name: "did_doc", Type: proto.ColumnType_JSON, Description: "The DID document of the repository.", Transform: transform.FromField("did_doc")},
+			{Name: "record_count", Type: proto.ColumnType_INT, Description: "Number of records in the collection. Counting pages through every record, so only select it when needed.", Hydrate: getRepoCollectionRecordCount, Transform: transform.FromValue()},
+		},
+	}
+}
+
+func listRepoCollection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	repo := strings.TrimPrefix(d.EqualsQualString("repo"), "@")
+	if repo == "" {
+		logger.Error("listRepoCollection: No repo specified")
+		return nil, fmt.Errorf("repo must be specified")
+	}
+
+	// The repo is described by its own PDS, so no authentication is needed
+	client, ident, err := repoClient(ctx, repo)
+	if err != nil {
+		logger.Error("listRepoCollection: Failed to resolve repo", "error", err, "repo", repo)
+		return nil, err
+	}
+
+	out, err := atproto.RepoDescribeRepo(ctx, client, ident.DID.String())
+	if err != nil {
+		logger.Error("listRepoCollection: Failed to describe repo", "error", err, "repo", repo)
+		return nil, fmt.Errorf("failed to describe repo %s: %w", repo, err)
+	}
+
+	for _, collection := range out.Collections {
+		item := map[string]interface{}{
+			"collection": collection,
+			// Keep the qual value as given so the key column matches
+			"repo":              d.EqualsQualString("repo"),
+			"did":               out.Did,
+			"handle":            out.Handle,
+			"handle_is_correct": out.HandleIsCorrect,
+			"did_doc":           out.DidDoc,
+		}
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getRepoCollectionRecordCount counts the records in a collection by paging
+// through listRecords.
+func getRepoCollectionRecordCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	item := h.Item.(map[string]interface{})
+	did := item["did"].(string)
+	collection := item["collection"].(string)
+
+	client, _, err := repoClient(ctx, did)
+	if err != nil {
+		logger.Error("getRepoCollectionRecordCount: Failed to resolve repo", "error", err, "did", did)
+		return nil, err
+	}
+
+	count := 0
+	cursor := ""
+	for {
+		out, err := listRepoRecords(ctx, client, did, collection, cursor, 100, false)
+		if err != nil {
+			logger.Error("getRepoCollectionRecordCount: Failed to list records", "error", err, "collection", collection)
+			return nil, fmt.Errorf("failed to list %s records for %s: %w", collection, did, err)
+		}
+		count += len(out.Records)
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Records) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,136 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyRepoRecord(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_repo_record",
+		Description: "Records of any collection in an atproto repository, read from the repository's own PDS.",
+		List: &plugin.ListConfig{
+			Hydrate: listRepoRecord,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "repo",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "collection",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "reverse",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: repoRecordColumns(
+			&plugin.Column{Name: "repo", Type: proto.ColumnType_STRING, Description: "The DID or handle of the repository.", Transform: transform.FromField("repo")},
+			&plugin.Column{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the repository.", Transform: transform.FromField("did")},
+			&plugin.Column{Name: "reverse", Type: proto.ColumnType_BOOL, Description: "Whether records are listed oldest first instead of newest first.", Transform: transform.FromField("reverse")},
+		),
+	}
+}
+
+// repoRecordColumns returns the columns shared by tables of raw repository
+// records, followed by any table specific columns.
+func repoRecordColumns(extraCols ...*plugin.Column) []*plugin.Column {
+	cols := []*plugin.Column{
+		{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the record.", Transform: transform.FromField("uri")},
+		{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the record.", Transform: transform.FromField("cid")},
+		{Name: "collection", Type: proto.ColumnType_STRING, Description: "The NSID of the collection holding the record.", Transform: transform.FromField("collection")},
+		{Name: "rkey", Type: proto.ColumnType_STRING, Description: "The record key of the record.", Transform: transform.FromField("rkey")},
+		{Name: "type", Type: proto.ColumnType_STRING, Description: "The $type of the record.", Transform: transform.FromField("type")},
+		{Name: "created_at", Type: proto.ColumnType_STRING, Description: "When the record was created, if the record has a createdAt field.", Transform: transform.FromField("created_at")},
+		{Name: "value", Type: proto.ColumnType_JSON, Description: "The full record.", Transform: transform.FromField("value")},
+	}
+	return append(cols, extraCols...)
+}
+
+// repoRecordItem builds a row matching repoRecordColumns from a raw record.
+func repoRecordItem(uri string, cid string, collection string, rkey string, value json.RawMessage) map[string]interface{} {
+	item := map[string]interface{}{
+		"uri":        uri,
+		"cid":        cid,
+		"collection": collection,
+		"rkey":       rkey,
+		"value":      value,
+	}
+
+	var header struct {
+		Type      string `json:"$type"`
+		CreatedAt string `json:"createdAt"`
+	}
+	if err := json.Unmarshal(value, &header); err == nil {
+		item["type"] = header.Type
+		item["created_at"] = header.CreatedAt
+	}
+	return item
+}
+
+func listRepoRecord(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	repo := strings.TrimPrefix(d.EqualsQualString("repo"), "@")
+	collection := d.EqualsQualString("collection")
+	if repo == "" || collection == "" {
+		logger.Error("listRepoRecord: No repo or collection specified")
+		return nil, fmt.Errorf("repo and collection must be specified")
+	}
+
+	reverse := false
+	if d.EqualsQuals["reverse"] != nil {
+		reverse = d.EqualsQuals["reverse"].GetBoolValue()
+	}
+
+	// Records are read from the repo's own PDS, so no authentication is needed
+	client, ident, err := repoClient(ctx, repo)
+	if err != nil {
+		logger.Error("listRepoRecord: Failed to resolve repo", "error", err, "repo", repo)
+		return nil, err
+	}
+
+	cursor := ""
+	for {
+		out, err := listRepoRecords(ctx, client, ident.DID.String(), collection, cursor, 100, reverse)
+		if err != nil {
+			logger.Error("listRepoRecord: Failed to list records", "error", err, "collection", collection)
+			return nil, fmt.Errorf("failed to list %s records for %s: %w", collection, repo, err)
+		}
+
+		for _, record := range out.Records {
+			item := repoRecordItem(record.Uri, record.Cid, collection, rkeyFromURI(record.Uri), record.Value)
+			// Keep the qual value as given so the key column matches
+			item["repo"] = d.EqualsQualString("repo")
+			item["did"] = ident.DID.String()
+			item["reverse"] = reverse
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Records) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
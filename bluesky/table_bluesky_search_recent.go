@@ -82,32 +82,13 @@ func listSearchRecent(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 			break
 		}
 
-		feedPost := post.Record.Val.(*bsky.FeedPost)
-		metadata := extractPostMetadata(feedPost)
-		mentionedDIDs := metadata["mentioned_handles"].([]string)
-		mentionedHandles := resolveDIDsToHandles(ctx, client, mentionedDIDs)
-
-		d.StreamListItem(ctx, map[string]interface{}{
-			"uri":                     post.Uri,
-			"http_url":                convertToHttpUrl(post.Uri),
-			"cid":                     post.Cid,
-			"text":                    feedPost.Text,
-			"author":                  post.Author.Handle,
-			"created_at":              feedPost.CreatedAt,
-			"indexed_at":              post.IndexedAt,
-			"like_count":              post.LikeCount,
-			"repost_count":            post.RepostCount,
-			"reply_root":              getReplyRoot(feedPost),
-			"reply_parent":            getReplyParent(feedPost),
-			"has_external_links":      metadata["has_external_links"],
-			"image_count":             metadata["image_count"],
-			"hashtags":                metadata["hashtags"],
-			"mentioned_handles":       metadata["mentioned_handles"],
-			"mentioned_handles_names": mentionedHandles,
-			"external_links":          metadata["external_links"],
-			"query":                   query,
-			"limit":                   limit,
-		})
+		postItem := postViewItem(ctx, client, post)
+		if postItem == nil {
+			continue
+		}
+		postItem["query"] = query
+		postItem["limit"] = limit
+		d.StreamListItem(ctx, postItem)
 
 		totalReturned++
 
@@ -138,32 +119,13 @@ func listSearchRecent(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 				break
 			}
 
-			feedPost := post.Record.Val.(*bsky.FeedPost)
-			metadata := extractPostMetadata(feedPost)
-			mentionedDIDs := metadata["mentioned_handles"].([]string)
-			mentionedHandles := resolveDIDsToHandles(ctx, client, mentionedDIDs)
-
-			d.StreamListItem(ctx, map[string]interface{}{
-				"uri":                     post.Uri,
-				"http_url":                convertToHttpUrl(post.Uri),
-				"cid":                     post.Cid,
-				"text":                    feedPost.Text,
-				"author":                  post.Author.Handle,
-				"created_at":              feedPost.CreatedAt,
-				"indexed_at":              post.IndexedAt,
-				"like_count":              post.LikeCount,
-				"repost_count":            post.RepostCount,
-				"reply_root":              getReplyRoot(feedPost),
-				"reply_parent":            getReplyParent(feedPost),
-				"has_external_links":      metadata["has_external_links"],
-				"image_count":             metadata["image_count"],
-				"hashtags":                metadata["hashtags"],
-				"mentioned_handles":       metadata["mentioned_handles"],
-				"mentioned_handles_names": mentionedHandles,
-				"external_links":          metadata["external_links"],
-				"query":                   query,
-				"limit":                   limit,
-			})
+			postItem := postViewItem(ctx, client, post)
+			if postItem == nil {
+				continue
+			}
+			postItem["query"] = query
+			postItem["limit"] = limit
+			d.StreamListItem(ctx, postItem)
 
 			totalReturned++
 
@@ -0,0 +1,193 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyStarterPack(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_starter_pack",
+		Description: "Bluesky starter packs, looked up by creator, by URI or by keyword search.",
+		List: &plugin.ListConfig{
+			Hydrate: listStarterPack,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "creator_did",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "creator_handle",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "uri",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "http_url",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "query",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns: []*plugin.Column{
+			{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the starter pack.", Transform: transform.FromField("uri")},
+			{Name: "http_url", Type: proto.ColumnType_STRING, Description: "The HTTP URL for the starter pack on bsky.app.", Transform: transform.FromField("http_url")},
+			{Name: "cid", Type: proto.ColumnType_STRING, Description: "The CID of the starter pack record.", Transform: transform.FromField("cid")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the starter pack.", Transform: transform.FromField("name")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the starter pack.", Transform: transform.FromField("description")},
+			{Name: "creator_did", Type: proto.ColumnType_STRING, Description: "The DID of the user who created the starter pack.", Transform: transform.FromField("creator_did")},
+			{Name: "creator_handle", Type: proto.ColumnType_STRING, Description: "The handle of the user who created the starter pack.", Transform: transform.FromField("creator_handle")},
+			{Name: "list_uri", Type: proto.ColumnType_STRING, Description: "The URI of the list holding the starter pack's users.", Transform: transform.FromField("list_uri")},
+			{Name: "list_item_count", Type: proto.ColumnType_INT, Description: "Number of users in the starter pack.", Transform: transform.FromField("list_item_count")},
+			{Name: "feeds", Type: proto.ColumnType_JSON, Description: "List of URIs of the feeds included in the starter pack.", Transform: transform.FromField("feeds")},
+			{Name: "joined_week_count", Type: proto.ColumnType_INT, Description: "Number of users who joined Bluesky through the starter pack in the last week.", Transform: transform.FromField("joined_week_count")},
+			{Name: "joined_all_time_count", Type: proto.ColumnType_INT, Description: "Number of users who joined Bluesky through the starter pack.", Transform: transform.FromField("joined_all_time_count")},
+			{Name: "labels", Type: proto.ColumnType_JSON, Description: "Moderation labels applied to the starter pack.", Transform: transform.FromField("labels")},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Description: "When the starter pack was created.", Transform: transform.FromField("created_at")},
+			{Name: "indexed_at", Type: proto.ColumnType_STRING, Description: "When the starter pack was indexed.", Transform: transform.FromField("indexed_at")},
+			{Name: "query", Type: proto.ColumnType_STRING, Description: "The search query used to find this starter pack.", Transform: transform.FromField("query")},
+		},
+	}
+}
+
+// starterPackViewItem builds a starter pack row from a basic starter pack view.
+func starterPackViewItem(view *bsky.GraphDefs_StarterPackViewBasic) map[string]interface{} {
+	item := map[string]interface{}{
+		"uri":                   view.Uri,
+		"http_url":              convertToHttpUrl(view.Uri),
+		"cid":                   view.Cid,
+		"list_item_count":       derefInt64(view.ListItemCount),
+		"joined_week_count":     derefInt64(view.JoinedWeekCount),
+		"joined_all_time_count": derefInt64(view.JoinedAllTimeCount),
+		"labels":                labelItems(view.Labels),
+		"indexed_at":            view.IndexedAt,
+	}
+	if view.Creator != nil {
+		item["creator_did"] = view.Creator.Did
+		item["creator_handle"] = view.Creator.Handle
+	}
+	if view.Record != nil {
+		if record, ok := view.Record.Val.(*bsky.GraphStarterpack); ok {
+			feeds := make([]string, 0, len(record.Feeds))
+			for _, feed := range record.Feeds {
+				feeds = append(feeds, feed.Uri)
+			}
+			item["name"] = record.Name
+			item["description"] = derefString(record.Description)
+			item["list_uri"] = record.List
+			item["feeds"] = feeds
+			item["created_at"] = record.CreatedAt
+		}
+	}
+	return item
+}
+
+func listStarterPack(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listStarterPack: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Look up specific starter packs by URI or bsky.app URL
+	uri := d.EqualsQualString("uri")
+	httpUrl := d.EqualsQualString("http_url")
+	fromHttpUrl := uri == "" && httpUrl != ""
+	if fromHttpUrl {
+		uri, err = convertToAtURI(ctx, client, httpUrl)
+		if err != nil {
+			logger.Error("listStarterPack: Error converting HTTP URL to URI", "error", err, "http_url", httpUrl)
+			return nil, fmt.Errorf("failed to convert HTTP URL to URI: %w", err)
+		}
+	}
+	if uri != "" {
+		out, err := bsky.GraphGetStarterPacks(ctx, client, []string{uri})
+		if err != nil {
+			logger.Error("listStarterPack: Failed to get starter packs", "error", err)
+			return nil, fmt.Errorf("failed to get starter packs: %w", err)
+		}
+		for _, view := range out.StarterPacks {
+			item := starterPackViewItem(view)
+			// Keep the qual value as given so the key column matches
+			if fromHttpUrl {
+				item["http_url"] = httpUrl
+			}
+			d.StreamListItem(ctx, item)
+		}
+		return nil, nil
+	}
+
+	actor := d.EqualsQualString("creator_did")
+	if actor != "" && !strings.HasPrefix(actor, "did:") {
+		logger.Error("listStarterPack: Invalid DID format", "did", actor)
+		return nil, fmt.Errorf("invalid DID format: %s", actor)
+	}
+	if actor == "" {
+		actor = strings.TrimPrefix(d.EqualsQualString("creator_handle"), "@")
+	}
+	query := d.EqualsQualString("query")
+	if actor == "" && query == "" {
+		logger.Error("listStarterPack: No creator, starter pack or query specified")
+		return nil, fmt.Errorf("one of creator_did, creator_handle, uri, http_url or query must be specified")
+	}
+
+	cursor := ""
+	for {
+		var packs []*bsky.GraphDefs_StarterPackViewBasic
+		var next *string
+		if actor != "" {
+			out, err := bsky.GraphGetActorStarterPacks(ctx, client, actor, cursor, 100)
+			if err != nil {
+				logger.Error("listStarterPack: Failed to get actor starter packs", "error", err, "actor", actor)
+				return nil, fmt.Errorf("failed to get starter packs for %s: %w", actor, err)
+			}
+			packs, next = out.StarterPacks, out.Cursor
+		} else {
+			out, err := bsky.GraphSearchStarterPacks(ctx, client, cursor, 100, query)
+			if err != nil {
+				logger.Error("listStarterPack: Failed to search starter packs", "error", err, "query", query)
+				return nil, fmt.Errorf("failed to search starter packs: %w", err)
+			}
+			packs, next = out.StarterPacks, out.Cursor
+		}
+
+		for _, view := range packs {
+			item := starterPackViewItem(view)
+			if query != "" {
+				item["query"] = query
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if next == nil || *next == "" || len(packs) == 0 {
+			break
+		}
+		cursor = *next
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,81 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyStarterPackMember(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_starter_pack_member",
+		Description: "List of users included in a Bluesky starter pack.",
+		List: &plugin.ListConfig{
+			Hydrate: listStarterPackMember,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "starter_pack_uri",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          userColumns("starter_pack_uri", "list_uri", "list_item_uri"),
+	}
+}
+
+func listStarterPackMember(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	starterPackURI := d.EqualsQualString("starter_pack_uri")
+	if starterPackURI == "" {
+		logger.Error("listStarterPackMember: No starter_pack_uri specified")
+		return nil, fmt.Errorf("starter_pack_uri must be specified")
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listStarterPackMember: Failed to connect", "error", err)
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	// Accept both at:// URIs and bsky.app starter pack URLs
+	uri, err := convertToAtURI(ctx, client, starterPackURI)
+	if err != nil {
+		logger.Error("listStarterPackMember: Error converting starter pack URL to URI", "error", err, "starter_pack_uri", starterPackURI)
+		return nil, fmt.Errorf("failed to convert starter pack URL to URI: %w", err)
+	}
+	if !strings.Contains(uri, "/app.bsky.graph.starterpack/") {
+		logger.Error("listStarterPackMember: Not a starter pack URI", "uri", uri)
+		return nil, fmt.Errorf("starter_pack_uri must refer to an app.bsky.graph.starterpack record: %s", starterPackURI)
+	}
+
+	pack, err := bsky.GraphGetStarterPack(ctx, client, uri)
+	if err != nil {
+		logger.Error("listStarterPackMember: Failed to get starter pack", "error", err, "uri", uri)
+		return nil, fmt.Errorf("failed to get starter pack %s: %w", uri, err)
+	}
+	if pack.StarterPack == nil || pack.StarterPack.List == nil {
+		logger.Debug("listStarterPackMember: Starter pack has no list", "uri", uri)
+		return nil, nil
+	}
+	listURI := pack.StarterPack.List.Uri
+
+	// The starter pack's users are the members of its backing list. Keep the
+	// qual value as given so the key column matches.
+	fields := map[string]interface{}{
+		"starter_pack_uri": starterPackURI,
+		"list_uri":         listURI,
+	}
+	if err := streamListMembers(ctx, d, client, listURI, fields); err != nil {
+		logger.Error("listStarterPackMember: Failed to stream list members", "error", err, "uri", listURI)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,78 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskySuggestedFollow(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_suggested_follow",
+		Description: "Accounts Bluesky suggests following based on a specific user.",
+		List: &plugin.ListConfig{
+			Hydrate: listSuggestedFollow,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "target_did",
+					Require: plugin.Required,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          userColumns("target_did", "is_fallback"),
+	}
+}
+
+func listSuggestedFollow(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	targetDid := d.EqualsQualString("target_did")
+	if targetDid == "" {
+		logger.Error("listSuggestedFollow: No target_did specified")
+		return nil, fmt.Errorf("target_did must be specified")
+	}
+
+	if !strings.HasPrefix(targetDid, "did:") {
+		logger.Error("listSuggestedFollow: Invalid DID format", "did", targetDid)
+		return nil, fmt.Errorf("invalid DID format: %s", targetDid)
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("listSuggestedFollow: Error connecting", "error", err)
+		return nil, err
+	}
+
+	// Suggestions by actor are a single, unpaginated page
+	out, err := bsky.GraphGetSuggestedFollowsByActor(ctx, client, targetDid)
+	if err != nil {
+		logger.Error("listSuggestedFollow: Error getting suggested follows", "error", err, "did", targetDid)
+		return nil, fmt.Errorf("failed to get suggested follows for %s: %v", targetDid, err)
+	}
+
+	items, err := profileViewItems(ctx, client, out.Suggestions)
+	if err != nil {
+		logger.Error("listSuggestedFollow: Error getting suggestion profiles", "error", err, "did", targetDid)
+		return nil, err
+	}
+
+	isFallback := out.IsFallback != nil && *out.IsFallback
+	for _, item := range items {
+		item["target_did"] = targetDid
+		item["is_fallback"] = isFallback
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -99,6 +99,7 @@ func listUser(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 		"post_count":      derefInt64(profile.PostsCount),
 		"avatar":          derefString(profile.Avatar),
 		"banner":          derefString(profile.Banner),
+		"labels":          labelItems(profile.Labels),
 	}
 
 	logger.Debug("listUser: Streaming item", "did", did, "handle", profile.Handle)
@@ -60,6 +60,7 @@ func processFollower(ctx context.Context, d *plugin.QueryData, follower *bsky.Ac
 		"post_count":      derefInt64(profile.PostsCount),
 		"avatar":          derefString(profile.Avatar),
 		"banner":          derefString(profile.Banner),
+		"labels":          labelItems(profile.Labels),
 	}
 
 	logger.Debug("listUserFollower: Created item map with keys", "keys", getMapKeys(item))
@@ -58,6 +58,7 @@ func processFollowing(ctx context.Context, d *plugin.QueryData, following *bsky.
 		"post_count":      derefInt64(profile.PostsCount),
 		"avatar":          derefString(profile.Avatar),
 		"banner":          derefString(profile.Banner),
+		"labels":          labelItems(profile.Labels),
 	}
 
 	logger.Debug("listUserFollowing: Created item map with keys", "keys", getMapKeys(item))
@@ -0,0 +1,234 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyUserLike(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_user_like",
+		Description: "List of likes made by a specific Bluesky user, read from their repository.",
+		List: &plugin.ListConfig{
+			Hydrate: listUserLike,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "target_did",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "handle",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          subjectRecordColumns("like", "liked"),
+	}
+}
+
+// subjectRecordColumns returns the columns for records that point at a post,
+// such as likes and reposts. The participle describes the subject post, such
+// as "liked".
+func subjectRecordColumns(kind string, participle string) []*plugin.Column {
+	return []*plugin.Column{
+		{Name: "uri", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("The URI of the %s record.", kind), Transform: transform.FromField("uri")},
+		{Name: "cid", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("The CID of the %s record.", kind), Transform: transform.FromField("cid")},
+		{Name: "rkey", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("The record key of the %s record.", kind), Transform: transform.FromField("rkey")},
+		{Name: "subject_uri", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("The URI of the %s post.", participle), Transform: transform.FromField("subject_uri")},
+		{Name: "subject_cid", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("The CID of the %s post.", participle), Transform: transform.FromField("subject_cid")},
+		{Name: "created_at", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("When the %s was created.", kind), Transform: transform.FromField("created_at")},
+		{Name: "target_did", Type: proto.ColumnType_STRING, Description: "The DID of the target user.", Transform: transform.FromField("target_did")},
+		{Name: "handle", Type: proto.ColumnType_STRING, Description: "The handle of the target user.", Transform: transform.FromField("handle")},
+		{Name: "subject_author", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("The handle of the author of the %s post.", participle), Transform: transform.FromField("subject_author")},
+		{Name: "subject_text", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("The text content of the %s post.", participle), Transform: transform.FromField("subject_text")},
+		{Name: "subject_created_at", Type: proto.ColumnType_STRING, Description: fmt.Sprintf("When the %s post was created.", participle), Transform: transform.FromField("subject_created_at")},
+		{Name: "subject_like_count", Type: proto.ColumnType_INT, Description: fmt.Sprintf("Number of likes on the %s post.", participle), Transform: transform.FromField("subject_like_count")},
+		{Name: "subject_repost_count", Type: proto.ColumnType_INT, Description: fmt.Sprintf("Number of reposts of the %s post.", participle), Transform: transform.FromField("subject_repost_count")},
+	}
+}
+
+// subjectRecord holds the fields shared by app.bsky.feed.like and
+// app.bsky.feed.repost records.
+type subjectRecord struct {
+	Subject *struct {
+		Uri string `json:"uri"`
+		Cid string `json:"cid"`
+	} `json:"subject"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func listUserLike(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listSubjectRecords(ctx, d, "app.bsky.feed.like")
+}
+
+// listSubjectRecords lists the records of a collection that point at a post,
+// reading them directly from the target user's repository on their PDS.
+func listSubjectRecords(ctx context.Context, d *plugin.QueryData, collection string) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	var targetDid string
+	var handle string
+
+	// Check if DID is provided
+	if d.EqualsQuals["target_did"] != nil {
+		targetDid = d.EqualsQuals["target_did"].GetStringValue()
+		if targetDid != "" && !strings.HasPrefix(targetDid, "did:") {
+			logger.Error("listSubjectRecords: Invalid DID format", "did", targetDid)
+			return nil, fmt.Errorf("invalid DID format: %s", targetDid)
+		}
+	}
+
+	// Check if handle is provided
+	if d.EqualsQuals["handle"] != nil {
+		handle = strings.TrimPrefix(d.EqualsQuals["handle"].GetStringValue(), "@")
+	}
+
+	// If neither DID nor handle is provided, return error
+	if targetDid == "" && handle == "" {
+		logger.Error("listSubjectRecords: No target_did or handle specified")
+		return nil, fmt.Errorf("either target_did or handle must be specified")
+	}
+
+	repo := targetDid
+	if repo == "" {
+		repo = handle
+	}
+
+	// Records are read from the user's own PDS, so no authentication is needed
+	client, ident, err := repoClient(ctx, repo)
+	if err != nil {
+		logger.Error("listSubjectRecords: Failed to resolve repo", "error", err, "repo", repo)
+		return nil, err
+	}
+
+	// The subject posts are only fetched when their columns are selected
+	withSubjects := slices.ContainsFunc(d.QueryContext.Columns, func(col string) bool {
+		return strings.HasPrefix(col, "subject_") && col != "subject_uri" && col != "subject_cid"
+	})
+
+	cursor := ""
+	for {
+		out, err := listRepoRecords(ctx, client, ident.DID.String(), collection, cursor, 100, false)
+		if err != nil {
+			logger.Error("listSubjectRecords: Failed to list records", "error", err, "collection", collection)
+			return nil, fmt.Errorf("failed to list %s records for %s: %w", collection, repo, err)
+		}
+
+		items := []map[string]interface{}{}
+		for _, record := range out.Records {
+			var value subjectRecord
+			if err := json.Unmarshal(record.Value, &value); err != nil {
+				logger.Warn("listSubjectRecords: Skipping undecodable record", "error", err, "uri", record.Uri)
+				continue
+			}
+
+			item := map[string]interface{}{
+				"uri":        record.Uri,
+				"cid":        record.Cid,
+				"rkey":       rkeyFromURI(record.Uri),
+				"created_at": value.CreatedAt,
+				"target_did": ident.DID.String(),
+				"handle":     handle,
+			}
+			if value.Subject != nil {
+				item["subject_uri"] = value.Subject.Uri
+				item["subject_cid"] = value.Subject.Cid
+			}
+			items = append(items, item)
+		}
+
+		if withSubjects {
+			if err := addSubjectPosts(ctx, d, items); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, item := range items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Records) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil, nil
+}
+
+// addSubjectPosts adds the details of the posts a page of likes or reposts
+// point at to their rows, fetching the posts in batches. Posts that have
+// since been deleted are left out, so their columns are null.
+func addSubjectPosts(ctx context.Context, d *plugin.QueryData, items []map[string]interface{}) error {
+	logger := plugin.Logger(ctx)
+
+	var uris []string
+	for _, item := range items {
+		if uri, _ := item["subject_uri"].(string); uri != "" && !slices.Contains(uris, uri) {
+			uris = append(uris, uri)
+		}
+	}
+	if len(uris) == 0 {
+		return nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		logger.Error("addSubjectPosts: Failed to connect", "error", err)
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+
+	posts := map[string]*bsky.FeedDefs_PostView{}
+	// getPosts accepts at most 25 URIs per call
+	for start := 0; start < len(uris); start += 25 {
+		end := min(start+25, len(uris))
+		out, err := bsky.FeedGetPosts(ctx, client, uris[start:end])
+		if err != nil {
+			logger.Error("addSubjectPosts: Failed to get posts", "error", err)
+			return fmt.Errorf("failed to get posts: %w", err)
+		}
+		for _, post := range out.Posts {
+			posts[post.Uri] = post
+		}
+	}
+
+	for _, item := range items {
+		uri, _ := item["subject_uri"].(string)
+		post, ok := posts[uri]
+		if !ok {
+			continue
+		}
+		item["subject_like_count"] = post.LikeCount
+		item["subject_repost_count"] = post.RepostCount
+		if post.Author != nil {
+			item["subject_author"] = post.Author.Handle
+		}
+		if post.Record != nil {
+			if feedPost, ok := post.Record.Val.(*bsky.FeedPost); ok {
+				item["subject_text"] = feedPost.Text
+				item["subject_created_at"] = feedPost.CreatedAt
+			}
+		}
+	}
+
+	return nil
+}
@@ -65,31 +65,12 @@ func listUserMentions(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	}
 
 	for _, post := range searchResults.Posts {
-		feedPost := post.Record.Val.(*bsky.FeedPost)
-		metadata := extractPostMetadata(feedPost)
-		mentionedDIDs := metadata["mentioned_handles"].([]string)
-		mentionedHandles := resolveDIDsToHandles(ctx, client, mentionedDIDs)
-
-		d.StreamListItem(ctx, map[string]interface{}{
-			"uri":                     post.Uri,
-			"http_url":                convertToHttpUrl(post.Uri),
-			"cid":                     post.Cid,
-			"text":                    feedPost.Text,
-			"author":                  post.Author.Handle,
-			"created_at":              feedPost.CreatedAt,
-			"indexed_at":              post.IndexedAt,
-			"like_count":              post.LikeCount,
-			"repost_count":            post.RepostCount,
-			"reply_root":              getReplyRoot(feedPost),
-			"reply_parent":            getReplyParent(feedPost),
-			"has_external_links":      metadata["has_external_links"],
-			"image_count":             metadata["image_count"],
-			"hashtags":                metadata["hashtags"],
-			"mentioned_handles":       metadata["mentioned_handles"],
-			"mentioned_handles_names": mentionedHandles,
-			"external_links":          metadata["external_links"],
-			"target_did":              targetDid,
-		})
+		postItem := postViewItem(ctx, client, post)
+		if postItem == nil {
+			continue
+		}
+		postItem["target_did"] = targetDid
+		d.StreamListItem(ctx, postItem)
 	}
 
 	// Handle pagination
@@ -102,31 +83,12 @@ func listUserMentions(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		}
 
 		for _, post := range nextResults.Posts {
-			feedPost := post.Record.Val.(*bsky.FeedPost)
-			metadata := extractPostMetadata(feedPost)
-			mentionedDIDs := metadata["mentioned_handles"].([]string)
-			mentionedHandles := resolveDIDsToHandles(ctx, client, mentionedDIDs)
-
-			d.StreamListItem(ctx, map[string]interface{}{
-				"uri":                     post.Uri,
-				"http_url":                convertToHttpUrl(post.Uri),
-				"cid":                     post.Cid,
-				"text":                    feedPost.Text,
-				"author":                  post.Author.Handle,
-				"created_at":              feedPost.CreatedAt,
-				"indexed_at":              post.IndexedAt,
-				"like_count":              post.LikeCount,
-				"repost_count":            post.RepostCount,
-				"reply_root":              getReplyRoot(feedPost),
-				"reply_parent":            getReplyParent(feedPost),
-				"has_external_links":      metadata["has_external_links"],
-				"image_count":             metadata["image_count"],
-				"hashtags":                metadata["hashtags"],
-				"mentioned_handles":       metadata["mentioned_handles"],
-				"mentioned_handles_names": mentionedHandles,
-				"external_links":          metadata["external_links"],
-				"target_did":              targetDid,
-			})
+			postItem := postViewItem(ctx, client, post)
+			if postItem == nil {
+				continue
+			}
+			postItem["target_did"] = targetDid
+			d.StreamListItem(ctx, postItem)
 		}
 
 		cursor = nextResults.Cursor
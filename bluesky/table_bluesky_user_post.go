@@ -91,32 +91,13 @@ func listUserPosts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	}
 
 	for _, item := range feed.Feed {
-		feedPost := item.Post.Record.Val.(*bsky.FeedPost)
-		metadata := extractPostMetadata(feedPost)
-		mentionedDIDs := metadata["mentioned_handles"].([]string)
-		mentionedHandles := resolveDIDsToHandles(ctx, client, mentionedDIDs)
-
-		d.StreamListItem(ctx, map[string]interface{}{
-			"uri":                     item.Post.Uri,
-			"http_url":                convertToHttpUrl(item.Post.Uri),
-			"cid":                     item.Post.Cid,
-			"text":                    feedPost.Text,
-			"author":                  item.Post.Author.Handle,
-			"created_at":              feedPost.CreatedAt,
-			"indexed_at":              item.Post.IndexedAt,
-			"like_count":              item.Post.LikeCount,
-			"repost_count":            item.Post.RepostCount,
-			"reply_root":              getReplyRoot(feedPost),
-			"reply_parent":            getReplyParent(feedPost),
-			"has_external_links":      metadata["has_external_links"],
-			"image_count":             metadata["image_count"],
-			"hashtags":                metadata["hashtags"],
-			"mentioned_handles":       metadata["mentioned_handles"],
-			"mentioned_handles_names": mentionedHandles,
-			"external_links":          metadata["external_links"],
-			"target_did":              targetDid,
-			"handle":                  handle,
-		})
+		postItem := postViewItem(ctx, client, item.Post)
+		if postItem == nil {
+			continue
+		}
+		postItem["target_did"] = targetDid
+		postItem["handle"] = handle
+		d.StreamListItem(ctx, postItem)
 	}
 
 	// Handle pagination
@@ -132,32 +113,13 @@ func listUserPosts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		}
 
 		for _, item := range nextFeed.Feed {
-			feedPost := item.Post.Record.Val.(*bsky.FeedPost)
-			metadata := extractPostMetadata(feedPost)
-			mentionedDIDs := metadata["mentioned_handles"].([]string)
-			mentionedHandles := resolveDIDsToHandles(ctx, client, mentionedDIDs)
-
-			d.StreamListItem(ctx, map[string]interface{}{
-				"uri":                     item.Post.Uri,
-				"http_url":                convertToHttpUrl(item.Post.Uri),
-				"cid":                     item.Post.Cid,
-				"text":                    feedPost.Text,
-				"author":                  item.Post.Author.Handle,
-				"created_at":              feedPost.CreatedAt,
-				"indexed_at":              item.Post.IndexedAt,
-				"like_count":              item.Post.LikeCount,
-				"repost_count":            item.Post.RepostCount,
-				"reply_root":              getReplyRoot(feedPost),
-				"reply_parent":            getReplyParent(feedPost),
-				"has_external_links":      metadata["has_external_links"],
-				"image_count":             metadata["image_count"],
-				"hashtags":                metadata["hashtags"],
-				"mentioned_handles":       metadata["mentioned_handles"],
-				"mentioned_handles_names": mentionedHandles,
-				"external_links":          metadata["external_links"],
-				"target_did":              targetDid,
-				"handle":                  handle,
-			})
+			postItem := postViewItem(ctx, client, item.Post)
+			if postItem == nil {
+				continue
+			}
+			postItem["target_did"] = targetDid
+			postItem["handle"] = handle
+			d.StreamListItem(ctx, postItem)
 		}
 
 		cursor = nextFeed.Cursor
@@ -0,0 +1,35 @@
+package bluesky
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableBlueskyUserRepost(ctx context.Context) *plugin.Table {
+
+	return &plugin.Table{
+		Name:        "bluesky_user_repost",
+		Description: "List of reposts made by a specific Bluesky user, read from their repository.",
+		List: &plugin.ListConfig{
+			Hydrate: listUserRepost,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "target_did",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "handle",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		DefaultTransform: transform.FromGo().NullIfZero(),
+		Columns:          subjectRecordColumns("repost", "reposted"),
+	}
+}
+
+func listUserRepost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listSubjectRecords(ctx, d, "app.bsky.feed.repost")
+}
@@ -2,12 +2,20 @@ package bluesky
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bluesky-social/indigo/api/atproto"
 	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/atproto/identity"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/bluesky-social/indigo/lex/util"
 	"github.com/bluesky-social/indigo/xrpc"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -20,6 +28,10 @@ var (
 	xrpcClientsMu sync.Mutex
 )
 
+// identityDirectory resolves handles and DIDs to identities, caching results
+// across queries.
+var identityDirectory = identity.DefaultDirectory()
+
 // connect ensures an authenticated XRPC client is available for the connection.
 // It handles reuse and creation of clients.
 func connect(ctx context.Context, d *plugin.QueryData) (*xrpc.Client, error) {
@@ -59,6 +71,14 @@ func connect(ctx context.Context, d *plugin.QueryData) (*xrpc.Client, error) {
 		Host: pdsHost,
 	}
 
+	// Ask the AppView to apply labels from these labelers in addition to the
+	// Bluesky moderation service
+	if len(blueskyConfig.AcceptLabelers) > 0 {
+		c.Headers = map[string]string{
+			"atproto-accept-labelers": strings.Join(blueskyConfig.AcceptLabelers, ", "),
+		}
+	}
+
 	sessResp, err := atproto.ServerCreateSession(ctx, c, &atproto.ServerCreateSession_Input{
 		Identifier: *blueskyConfig.Handle,
 		Password:   *blueskyConfig.AppPassword,
@@ -79,6 +99,64 @@ func connect(ctx context.Context, d *plugin.QueryData) (*xrpc.Client, error) {
 	return c, nil
 }
 
+// repoClient returns an unauthenticated XRPC client for the PDS that hosts
+// the repo of the given DID or handle, along with the resolved identity.
+func repoClient(ctx context.Context, repo string) (*xrpc.Client, *identity.Identity, error) {
+	atid, err := syntax.ParseAtIdentifier(strings.TrimPrefix(repo, "@"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid repo '%s': must be a DID or handle", repo)
+	}
+
+	ident, err := identityDirectory.Lookup(ctx, *atid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve repo '%s': %w", repo, err)
+	}
+
+	pdsHost := ident.PDSEndpoint()
+	if pdsHost == "" {
+		return nil, nil, fmt.Errorf("no PDS endpoint declared for %s", ident.DID)
+	}
+
+	return &xrpc.Client{Host: pdsHost}, ident, nil
+}
+
+// repoRecord is a record returned by com.atproto.repo.listRecords. The value
+// is kept as raw JSON so records of any collection can be read.
+type repoRecord struct {
+	Uri   string          `json:"uri"`
+	Cid   string          `json:"cid"`
+	Value json.RawMessage `json:"value"`
+}
+
+type repoListRecordsOutput struct {
+	Cursor  *string       `json:"cursor,omitempty"`
+	Records []*repoRecord `json:"records"`
+}
+
+// listRepoRecords calls com.atproto.repo.listRecords without decoding record
+// values, since the generated client fails on collections it doesn't know.
+func listRepoRecords(ctx context.Context, client *xrpc.Client, repo string, collection string, cursor string, limit int64, reverse bool) (*repoListRecordsOutput, error) {
+	var out repoListRecordsOutput
+
+	params := map[string]interface{}{
+		"collection": collection,
+		"cursor":     cursor,
+		"limit":      limit,
+		"repo":       repo,
+		"reverse":    reverse,
+	}
+	if err := client.Do(ctx, xrpc.Query, "", "com.atproto.repo.listRecords", params, nil, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// rkeyFromURI returns the record key, the last segment of an at:// URI.
+func rkeyFromURI(uri string) string {
+	return uri[strings.LastIndex(uri, "/")+1:]
+}
+
 // resolveDIDsToHandles resolves a list of DIDs to their corresponding handles
 func resolveDIDsToHandles(ctx context.Context, client *xrpc.Client, dids []string) []string {
 	handles := make([]string, 0, len(dids))
@@ -103,6 +181,231 @@ func resolveDIDsToHandles(ctx context.Context, client *xrpc.Client, dids []strin
 	return handles
 }
 
+// postEmbedColumns returns the columns describing the embed of a post,
+// matching the fields set by postEmbedItem.
+func postEmbedColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{Name: "embed_type", Type: proto.ColumnType_STRING, Description: "The NSID of the post embed. Possible values are: app.bsky.embed.images, app.bsky.embed.video, app.bsky.embed.external, app.bsky.embed.record, app.bsky.embed.recordWithMedia.", Transform: transform.FromField("embed_type")},
+		{Name: "quoted_uri", Type: proto.ColumnType_STRING, Description: "The URI of the quoted record, for record embeds.", Transform: transform.FromField("quoted_uri")},
+		{Name: "quoted_cid", Type: proto.ColumnType_STRING, Description: "The CID of the quoted record, for record embeds.", Transform: transform.FromField("quoted_cid")},
+		{Name: "external_uri", Type: proto.ColumnType_STRING, Description: "The URI of the external link card.", Transform: transform.FromField("external_uri")},
+		{Name: "external_title", Type: proto.ColumnType_STRING, Description: "The title of the external link card.", Transform: transform.FromField("external_title")},
+		{Name: "external_description", Type: proto.ColumnType_STRING, Description: "The description of the external link card.", Transform: transform.FromField("external_description")},
+		{Name: "external_thumb", Type: proto.ColumnType_STRING, Description: "The CDN URL of the external link card thumbnail.", Transform: transform.FromField("external_thumb")},
+		{Name: "video_cid", Type: proto.ColumnType_STRING, Description: "The CID of the embedded video blob.", Transform: transform.FromField("video_cid")},
+		{Name: "video_aspect_ratio", Type: proto.ColumnType_JSON, Description: "The width and height of the embedded video, if set by the author.", Transform: transform.FromField("video_aspect_ratio")},
+		{Name: "video_captions", Type: proto.ColumnType_JSON, Description: "The caption files of the embedded video, with their language.", Transform: transform.FromField("video_captions")},
+		{Name: "images_missing_alt_count", Type: proto.ColumnType_INT, Description: "Number of embedded images without alt text.", Transform: transform.FromField("images_missing_alt_count")},
+	}
+}
+
+// postMedia returns the images, video and external card of a post embed,
+// looking inside recordWithMedia embeds.
+func postMedia(post *bsky.FeedPost) (*bsky.EmbedImages, *bsky.EmbedVideo, *bsky.EmbedExternal) {
+	if post.Embed == nil {
+		return nil, nil, nil
+	}
+	if rwm := post.Embed.EmbedRecordWithMedia; rwm != nil {
+		if rwm.Media == nil {
+			return nil, nil, nil
+		}
+		return rwm.Media.EmbedImages, rwm.Media.EmbedVideo, rwm.Media.EmbedExternal
+	}
+	return post.Embed.EmbedImages, post.Embed.EmbedVideo, post.Embed.EmbedExternal
+}
+
+// postEmbedItem builds the postEmbedColumns fields of a row. The DID of the
+// author is needed to build CDN URLs.
+func postEmbedItem(did string, post *bsky.FeedPost) map[string]interface{} {
+	item := map[string]interface{}{}
+	if post.Embed == nil {
+		return item
+	}
+
+	switch {
+	case post.Embed.EmbedImages != nil:
+		item["embed_type"] = "app.bsky.embed.images"
+	case post.Embed.EmbedVideo != nil:
+		item["embed_type"] = "app.bsky.embed.video"
+	case post.Embed.EmbedExternal != nil:
+		item["embed_type"] = "app.bsky.embed.external"
+	case post.Embed.EmbedRecord != nil:
+		item["embed_type"] = "app.bsky.embed.record"
+		if ref := post.Embed.EmbedRecord.Record; ref != nil {
+			item["quoted_uri"] = ref.Uri
+			item["quoted_cid"] = ref.Cid
+		}
+	case post.Embed.EmbedRecordWithMedia != nil:
+		item["embed_type"] = "app.bsky.embed.recordWithMedia"
+		if rec := post.Embed.EmbedRecordWithMedia.Record; rec != nil && rec.Record != nil {
+			item["quoted_uri"] = rec.Record.Uri
+			item["quoted_cid"] = rec.Record.Cid
+		}
+	}
+
+	images, video, external := postMedia(post)
+	if images != nil {
+		missingAlt := 0
+		for _, image := range images.Images {
+			if image != nil && strings.TrimSpace(image.Alt) == "" {
+				missingAlt++
+			}
+		}
+		item["images_missing_alt_count"] = missingAlt
+	}
+	if video != nil && video.Video != nil {
+		item["video_cid"] = video.Video.Ref.String()
+		if video.AspectRatio != nil {
+			item["video_aspect_ratio"] = video.AspectRatio
+		}
+		var captions []map[string]interface{}
+		for _, caption := range video.Captions {
+			if caption == nil || caption.File == nil {
+				continue
+			}
+			captions = append(captions, map[string]interface{}{
+				"lang":      caption.Lang,
+				"cid":       caption.File.Ref.String(),
+				"mime_type": caption.File.MimeType,
+			})
+		}
+		if len(captions) > 0 {
+			item["video_captions"] = captions
+		}
+	}
+	if external != nil && external.External != nil {
+		item["external_uri"] = external.External.Uri
+		item["external_title"] = external.External.Title
+		item["external_description"] = external.External.Description
+		if external.External.Thumb != nil {
+			item["external_thumb"] = cdnImageURL("feed_thumbnail", did, external.External.Thumb)
+		}
+	}
+
+	return item
+}
+
+// cdnImageURL returns the Bluesky CDN URL of an image blob in the given
+// preset, such as feed_fullsize or feed_thumbnail.
+func cdnImageURL(preset string, did string, blob *util.LexBlob) string {
+	return fmt.Sprintf("https://cdn.bsky.app/img/%s/plain/%s/%s@jpeg", preset, did, blob.Ref.String())
+}
+
+// postRecordColumns returns the columns derived from the post record alone,
+// shared by the API and CAR backed post tables.
+func postRecordColumns() []*plugin.Column {
+	cols := postEmbedColumns()
+	return append(cols,
+		&plugin.Column{Name: "detected_hashtags", Type: proto.ColumnType_JSON, Description: "Hashtags detected in the post text, set when the text holds a mention, link or tag the post's facets don't cover.", Transform: transform.FromField("detected_hashtags")},
+		&plugin.Column{Name: "detected_links", Type: proto.ColumnType_JSON, Description: "Links detected in the post text, set when the text holds a mention, link or tag the post's facets don't cover.", Transform: transform.FromField("detected_links")},
+		&plugin.Column{Name: "detected_mentions", Type: proto.ColumnType_JSON, Description: "Handles detected as mentions in the post text, set when the text holds a mention, link or tag the post's facets don't cover.", Transform: transform.FromField("detected_mentions")},
+		&plugin.Column{Name: "langs", Type: proto.ColumnType_JSON, Description: "The languages of the post text declared by the author, as BCP-47 tags.", Transform: transform.FromField("langs")},
+		&plugin.Column{Name: "self_labels", Type: proto.ColumnType_JSON, Description: "The labels the author applied to the post, such as content warnings.", Transform: transform.FromField("self_labels")},
+		&plugin.Column{Name: "tags", Type: proto.ColumnType_JSON, Description: "The lowercased tags of the post, merging the tags set outside the text with the hashtags of its facets.", Transform: transform.FromField("tags")},
+	)
+}
+
+// postGateColumns returns the columns derived from the thread gate and viewer
+// state of a post view, matching the fields set by postGateItem.
+func postGateColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{Name: "threadgate_rules", Type: proto.ColumnType_JSON, Description: "Who may reply to the post, as a list of rules with a type of mention, follower, following or list. Empty if no one may reply, null if anyone may.", Transform: transform.FromField("threadgate_rules")},
+		{Name: "hidden_replies", Type: proto.ColumnType_JSON, Description: "The URIs of the replies the author hid with the thread gate.", Transform: transform.FromField("hidden_replies")},
+		{Name: "reply_disabled", Type: proto.ColumnType_BOOL, Description: "Whether the authenticated account is not allowed to reply to the post.", Transform: transform.FromField("reply_disabled")},
+		{Name: "embedding_disabled", Type: proto.ColumnType_BOOL, Description: "Whether the authenticated account is not allowed to quote the post.", Transform: transform.FromField("embedding_disabled")},
+	}
+}
+
+// postRecordItem builds the postRecordColumns fields of a row. The DID of the
+// author is needed to build CDN URLs.
+func postRecordItem(did string, post *bsky.FeedPost) map[string]interface{} {
+	item := postEmbedItem(did, post)
+	maps.Copy(item, postDetectedItem(post))
+
+	if len(post.Langs) > 0 {
+		item["langs"] = post.Langs
+	}
+	if post.Labels != nil && post.Labels.LabelDefs_SelfLabels != nil {
+		selfLabels := []string{}
+		for _, label := range post.Labels.LabelDefs_SelfLabels.Values {
+			if label != nil {
+				selfLabels = append(selfLabels, label.Val)
+			}
+		}
+		item["self_labels"] = selfLabels
+	}
+
+	// Tags are case-insensitive, so fold them to match across clients
+	tags := []string{}
+	seen := map[string]bool{}
+	addTag := func(tag string) {
+		tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	for _, tag := range post.Tags {
+		addTag(tag)
+	}
+	for _, facet := range post.Facets {
+		if facet == nil {
+			continue
+		}
+		for _, feature := range facet.Features {
+			if feature != nil && feature.RichtextFacet_Tag != nil {
+				addTag(feature.RichtextFacet_Tag.Tag)
+			}
+		}
+	}
+	if len(tags) > 0 {
+		item["tags"] = tags
+	}
+
+	return item
+}
+
+// postGateItem builds the postGateColumns fields of a row from the thread
+// gate and viewer state of a post view.
+func postGateItem(post *bsky.FeedDefs_PostView) map[string]interface{} {
+	item := map[string]interface{}{}
+
+	if post.Threadgate != nil && post.Threadgate.Record != nil {
+		if gate, ok := post.Threadgate.Record.Val.(*bsky.FeedThreadgate); ok {
+			// A gate without allow rules lets anyone reply, so leave the rules null
+			if gate.Allow != nil {
+				rules := []map[string]interface{}{}
+				for _, rule := range gate.Allow {
+					switch {
+					case rule == nil:
+						continue
+					case rule.FeedThreadgate_MentionRule != nil:
+						rules = append(rules, map[string]interface{}{"type": "mention"})
+					case rule.FeedThreadgate_FollowerRule != nil:
+						rules = append(rules, map[string]interface{}{"type": "follower"})
+					case rule.FeedThreadgate_FollowingRule != nil:
+						rules = append(rules, map[string]interface{}{"type": "following"})
+					case rule.FeedThreadgate_ListRule != nil:
+						rules = append(rules, map[string]interface{}{"type": "list", "list": rule.FeedThreadgate_ListRule.List})
+					}
+				}
+				item["threadgate_rules"] = rules
+			}
+			if len(gate.HiddenReplies) > 0 {
+				item["hidden_replies"] = gate.HiddenReplies
+			}
+		}
+	}
+
+	if post.Viewer != nil {
+		// Set explicitly so allowed posts return false rather than null
+		item["reply_disabled"] = post.Viewer.ReplyDisabled != nil && *post.Viewer.ReplyDisabled
+		item["embedding_disabled"] = post.Viewer.EmbeddingDisabled != nil && *post.Viewer.EmbeddingDisabled
+	}
+
+	return item
+}
+
 func postColumns(optionalCols ...string) []*plugin.Column {
 	cols := []*plugin.Column{
 		{Name: "uri", Type: proto.ColumnType_STRING, Description: "The URI of the post.", Transform: transform.FromField("uri")},
@@ -122,7 +425,10 @@ func postColumns(optionalCols ...string) []*plugin.Column {
 		{Name: "mentioned_handles", Type: proto.ColumnType_JSON, Description: "List of handles mentioned in the post.", Transform: transform.FromField("mentioned_handles")},
 		{Name: "mentioned_handles_names", Type: proto.ColumnType_JSON, Description: "List of handle names (not DIDs) mentioned in the post.", Transform: transform.FromField("mentioned_handles_names")},
 		{Name: "external_links", Type: proto.ColumnType_JSON, Description: "List of external links in the post.", Transform: transform.FromField("external_links")},
+		{Name: "labels", Type: proto.ColumnType_JSON, Description: "Moderation labels applied to the post.", Transform: transform.FromField("labels")},
 	}
+	cols = append(cols, postRecordColumns()...)
+	cols = append(cols, postGateColumns()...)
 
 	// Add optional columns
 	for _, col := range optionalCols {
@@ -155,12 +461,133 @@ func postColumns(optionalCols ...string) []*plugin.Column {
 				Description: "The maximum number of results to return.",
 				Transform:   transform.FromField("limit"),
 			})
+		case "feed_uri":
+			cols = append(cols, &plugin.Column{
+				Name:        "feed_uri",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URI of the feed generator that served this post.",
+				Transform:   transform.FromField("feed_uri"),
+			})
+		case "list_uri":
+			cols = append(cols, &plugin.Column{
+				Name:        "list_uri",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URI of the list whose members published the post.",
+				Transform:   transform.FromField("list_uri"),
+			})
+		case "feed_reason":
+			cols = append(cols,
+				&plugin.Column{
+					Name:        "reason_type",
+					Type:        proto.ColumnType_STRING,
+					Description: "Why the post appears in the feed, if not authored directly. Possible values are: repost, pin.",
+					Transform:   transform.FromField("reason_type"),
+				},
+				&plugin.Column{
+					Name:        "reposted_by",
+					Type:        proto.ColumnType_STRING,
+					Description: "The handle of the user who reposted the post into the feed.",
+					Transform:   transform.FromField("reposted_by"),
+				},
+				&plugin.Column{
+					Name:        "reposted_by_did",
+					Type:        proto.ColumnType_STRING,
+					Description: "The DID of the user who reposted the post into the feed.",
+					Transform:   transform.FromField("reposted_by_did"),
+				},
+				&plugin.Column{
+					Name:        "reposted_at",
+					Type:        proto.ColumnType_STRING,
+					Description: "When the repost was indexed.",
+					Transform:   transform.FromField("reposted_at"),
+				},
+				&plugin.Column{
+					Name:        "feed_context",
+					Type:        proto.ColumnType_STRING,
+					Description: "Opaque context provided by the feed generator for this item.",
+					Transform:   transform.FromField("feed_context"),
+				},
+			)
 		}
 	}
 
 	return cols
 }
 
+// postViewItem builds a row matching postColumns from a post view. It returns
+// nil if the post record is not an app.bsky.feed.post.
+func postViewItem(ctx context.Context, client *xrpc.Client, post *bsky.FeedDefs_PostView) map[string]interface{} {
+	if post == nil || post.Record == nil {
+		return nil
+	}
+	feedPost, ok := post.Record.Val.(*bsky.FeedPost)
+	if !ok {
+		return nil
+	}
+
+	metadata := extractPostMetadata(feedPost)
+	mentionedDIDs := metadata["mentioned_handles"].([]string)
+	mentionedHandles := resolveDIDsToHandles(ctx, client, mentionedDIDs)
+
+	author := ""
+	if post.Author != nil {
+		author = post.Author.Handle
+	}
+
+	item := map[string]interface{}{
+		"uri":                     post.Uri,
+		"http_url":                convertToHttpUrl(post.Uri),
+		"cid":                     post.Cid,
+		"text":                    feedPost.Text,
+		"author":                  author,
+		"created_at":              feedPost.CreatedAt,
+		"indexed_at":              post.IndexedAt,
+		"like_count":              post.LikeCount,
+		"repost_count":            post.RepostCount,
+		"reply_root":              getReplyRoot(feedPost),
+		"reply_parent":            getReplyParent(feedPost),
+		"has_external_links":      metadata["has_external_links"],
+		"image_count":             metadata["image_count"],
+		"hashtags":                metadata["hashtags"],
+		"mentioned_handles":       metadata["mentioned_handles"],
+		"mentioned_handles_names": mentionedHandles,
+		"external_links":          metadata["external_links"],
+		"labels":                  labelItems(post.Labels),
+	}
+	if post.Author != nil {
+		maps.Copy(item, postRecordItem(post.Author.Did, feedPost))
+	}
+	maps.Copy(item, postGateItem(post))
+	return item
+}
+
+// feedViewPostItem builds a row from a feed item, adding the repost or pin
+// reason and the feed context to the standard post fields.
+func feedViewPostItem(ctx context.Context, client *xrpc.Client, feedItem *bsky.FeedDefs_FeedViewPost) map[string]interface{} {
+	item := postViewItem(ctx, client, feedItem.Post)
+	if item == nil {
+		return nil
+	}
+
+	item["feed_context"] = derefString(feedItem.FeedContext)
+	if feedItem.Reason != nil {
+		switch {
+		case feedItem.Reason.FeedDefs_ReasonRepost != nil:
+			repost := feedItem.Reason.FeedDefs_ReasonRepost
+			item["reason_type"] = "repost"
+			item["reposted_at"] = repost.IndexedAt
+			if repost.By != nil {
+				item["reposted_by"] = repost.By.Handle
+				item["reposted_by_did"] = repost.By.Did
+			}
+		case feedItem.Reason.FeedDefs_ReasonPin != nil:
+			item["reason_type"] = "pin"
+		}
+	}
+
+	return item
+}
+
 func userColumns(optionalCols ...string) []*plugin.Column {
 	cols := []*plugin.Column{
 		{Name: "did", Type: proto.ColumnType_STRING, Description: "The DID of the user.", Transform: transform.FromField("did")},
@@ -173,6 +600,7 @@ func userColumns(optionalCols ...string) []*plugin.Column {
 		{Name: "post_count", Type: proto.ColumnType_INT, Description: "Number of posts by the user.", Transform: transform.FromField("post_count")},
 		{Name: "avatar", Type: proto.ColumnType_STRING, Description: "URL of the user's avatar image.", Transform: transform.FromField("avatar")},
 		{Name: "banner", Type: proto.ColumnType_STRING, Description: "URL of the user's banner image.", Transform: transform.FromField("banner")},
+		{Name: "labels", Type: proto.ColumnType_JSON, Description: "Moderation labels applied to the user.", Transform: transform.FromField("labels")},
 	}
 
 	for _, col := range optionalCols {
@@ -191,11 +619,243 @@ func userColumns(optionalCols ...string) []*plugin.Column {
 				Description: "The handle of the target user.",
 				Transform:   transform.FromField("handle"),
 			})
+		case "block_uri":
+			cols = append(cols, &plugin.Column{
+				Name:        "block_uri",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URI of the authenticated user's block record for the user.",
+				Transform:   transform.FromField("block_uri"),
+			})
+		case "starter_pack_uri":
+			cols = append(cols, &plugin.Column{
+				Name:        "starter_pack_uri",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URI of the starter pack.",
+				Transform:   transform.FromField("starter_pack_uri"),
+			})
+		case "list_uri":
+			cols = append(cols, &plugin.Column{
+				Name:        "list_uri",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URI of the list.",
+				Transform:   transform.FromField("list_uri"),
+			})
+		case "list_item_uri":
+			cols = append(cols, &plugin.Column{
+				Name:        "list_item_uri",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URI of the list item record that adds the user to the list.",
+				Transform:   transform.FromField("list_item_uri"),
+			})
+		case "is_fallback":
+			cols = append(cols, &plugin.Column{
+				Name:        "is_fallback",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Whether the suggestions fell back to generic results rather than ones based on the target user.",
+				Transform:   transform.FromField("is_fallback"),
+			})
 		}
 	}
 	return cols
 }
 
+// profileItem builds a row matching userColumns from a detailed profile.
+func profileItem(profile *bsky.ActorDefs_ProfileViewDetailed) map[string]interface{} {
+	return map[string]interface{}{
+		"did":             profile.Did,
+		"handle":          profile.Handle,
+		"display_name":    derefString(profile.DisplayName),
+		"description":     derefString(profile.Description),
+		"indexed_at":      derefString(profile.IndexedAt),
+		"follower_count":  derefInt64(profile.FollowersCount),
+		"following_count": derefInt64(profile.FollowsCount),
+		"post_count":      derefInt64(profile.PostsCount),
+		"avatar":          derefString(profile.Avatar),
+		"banner":          derefString(profile.Banner),
+		"labels":          labelItems(profile.Labels),
+	}
+}
+
+// profileViewItem builds a row matching userColumns from a profile view,
+// which does not include counts or a banner.
+func profileViewItem(profile *bsky.ActorDefs_ProfileView) map[string]interface{} {
+	return map[string]interface{}{
+		"did":          profile.Did,
+		"handle":       profile.Handle,
+		"display_name": derefString(profile.DisplayName),
+		"description":  derefString(profile.Description),
+		"indexed_at":   derefString(profile.IndexedAt),
+		"avatar":       derefString(profile.Avatar),
+		"labels":       labelItems(profile.Labels),
+	}
+}
+
+// getProfilesByDid fetches detailed profiles for the given actors in batches
+// of 25, the maximum accepted by getProfiles. Actors that can't be found are
+// left out of the result.
+func getProfilesByDid(ctx context.Context, client *xrpc.Client, actors []string) (map[string]*bsky.ActorDefs_ProfileViewDetailed, error) {
+	profiles := make(map[string]*bsky.ActorDefs_ProfileViewDetailed, len(actors))
+	for start := 0; start < len(actors); start += 25 {
+		end := start + 25
+		if end > len(actors) {
+			end = len(actors)
+		}
+
+		out, err := bsky.ActorGetProfiles(ctx, client, actors[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get profiles: %w", err)
+		}
+		for _, profile := range out.Profiles {
+			profiles[profile.Did] = profile
+		}
+	}
+	return profiles, nil
+}
+
+// profileViewItems builds userColumns rows for a page of profile views,
+// filling in counts and banners with a batched getProfiles call.
+func profileViewItems(ctx context.Context, client *xrpc.Client, views []*bsky.ActorDefs_ProfileView) ([]map[string]interface{}, error) {
+	dids := make([]string, 0, len(views))
+	for _, view := range views {
+		if view != nil {
+			dids = append(dids, view.Did)
+		}
+	}
+
+	profiles, err := getProfilesByDid(ctx, client, dids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]interface{}, 0, len(views))
+	for _, view := range views {
+		if view == nil {
+			continue
+		}
+		if profile, ok := profiles[view.Did]; ok {
+			items = append(items, profileItem(profile))
+		} else {
+			items = append(items, profileViewItem(view))
+		}
+	}
+	return items, nil
+}
+
+// streamListMembers streams a userColumns row for each member of the list at
+// uri, page by page, setting fields on every row.
+func streamListMembers(ctx context.Context, d *plugin.QueryData, client *xrpc.Client, uri string, fields map[string]interface{}) error {
+	logger := plugin.Logger(ctx)
+
+	cursor := ""
+	for {
+		out, err := bsky.GraphGetList(ctx, client, cursor, 100, uri)
+		if err != nil {
+			logger.Error("streamListMembers: Failed to get list", "error", err, "uri", uri)
+			return fmt.Errorf("failed to get list %s: %w", uri, err)
+		}
+
+		subjects := make([]*bsky.ActorDefs_ProfileView, 0, len(out.Items))
+		itemURIs := make(map[string]string, len(out.Items))
+		for _, listItem := range out.Items {
+			if listItem.Subject == nil {
+				continue
+			}
+			subjects = append(subjects, listItem.Subject)
+			itemURIs[listItem.Subject.Did] = listItem.Uri
+		}
+
+		items, err := profileViewItems(ctx, client, subjects)
+		if err != nil {
+			logger.Error("streamListMembers: Failed to get member profiles", "error", err, "uri", uri)
+			return err
+		}
+
+		for _, item := range items {
+			maps.Copy(item, fields)
+			item["list_item_uri"] = itemURIs[item["did"].(string)]
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Items) == 0 {
+			break
+		}
+		cursor = *out.Cursor
+
+		// Add a small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil
+}
+
+// labelItems converts moderation labels to the objects shown in the labels
+// column, leaving out signatures.
+func labelItems(labels []*atproto.LabelDefs_Label) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(labels))
+	for _, label := range labels {
+		if label == nil {
+			continue
+		}
+		item := map[string]interface{}{
+			"src": label.Src,
+			"uri": label.Uri,
+			"val": label.Val,
+			"neg": label.Neg != nil && *label.Neg,
+			"cts": label.Cts,
+		}
+		if label.Exp != nil {
+			item["exp"] = *label.Exp
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// expandHomeDir expands a leading ~/ in a path from the connection config to
+// the user's home directory, since HCL strings are not shell expanded.
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to expand %s: %w", path, err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+// qualStringValues returns the value of an equals qual as a slice, empty when
+// the qual isn't given. The SDK makes one list call per value of an IN list,
+// so there is at most one value.
+func qualStringValues(d *plugin.QueryData, name string) []string {
+	if s := d.EqualsQualString(name); s != "" {
+		return []string{s}
+	}
+	return nil
+}
+
+// qualJSONStrings decodes an equals qual on a JSON column holding an array of
+// strings. Array key columns let many values be sent in one request, since
+// the SDK makes one list call per value of an IN list.
+func qualJSONStrings(d *plugin.QueryData, name string) ([]string, error) {
+	qual := d.EqualsQuals[name]
+	if qual == nil {
+		return nil, nil
+	}
+
+	var values []string
+	if err := json.Unmarshal([]byte(qual.GetJsonbValue()), &values); err != nil {
+		return nil, fmt.Errorf("%s must be a JSON array of strings: %w", name, err)
+	}
+	return values, nil
+}
+
 // Helper functions for safe dereferencing
 func derefString(s *string) string {
 	if s == nil {
@@ -211,6 +871,16 @@ func derefInt64(i *int64) int64 {
 	return *i
 }
 
+func derefStrings(ss []*string) []string {
+	values := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s != nil {
+			values = append(values, *s)
+		}
+	}
+	return values
+}
+
 // convertToHttpUrl converts an at:// URI to a https://bsky.app URL
 func convertToHttpUrl(uri string) string {
 	if uri == "" {
@@ -224,5 +894,18 @@ func convertToHttpUrl(uri string) string {
 	}
 	did := parts[2]
 	rkey := parts[4]
-	return fmt.Sprintf("https://bsky.app/profile/%s/post/%s", did, rkey)
+
+	// Starter packs are not nested under the profile
+	if parts[3] == "app.bsky.graph.starterpack" {
+		return fmt.Sprintf("https://bsky.app/starter-pack/%s/%s", did, rkey)
+	}
+
+	// Feeds and lists use their own path segment in place of "post"
+	segment := "post"
+	for seg, nsid := range bskyAppCollections {
+		if nsid == parts[3] {
+			segment = seg
+		}
+	}
+	return fmt.Sprintf("https://bsky.app/profile/%s/%s/%s", did, segment, rkey)
 }
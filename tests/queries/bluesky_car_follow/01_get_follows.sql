@@ -0,0 +1,9 @@
+-- Test: Get follows in a CAR repository export
+select
+  uri,
+  subject_did,
+  created_at
+from
+  bluesky_car_follow
+where
+  path = '/tmp/bsky.app.car';
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- Test: Get likes in a CAR repository export
+select
+  uri,
+  subject_uri,
+  created_at
+from
+  bluesky_car_like
+where
+  path = '/tmp/bsky.app.car';
\ No newline at end of file
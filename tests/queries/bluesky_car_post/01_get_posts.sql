@@ -0,0 +1,10 @@
+-- Test: Get posts in a CAR repository export
+select
+  uri,
+  text,
+  created_at,
+  hashtags
+from
+  bluesky_car_post
+where
+  path = '/tmp/bsky.app.car';
\ No newline at end of file
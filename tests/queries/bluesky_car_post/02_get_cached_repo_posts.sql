@@ -0,0 +1,9 @@
+-- Test: Get posts from a cached repository
+select
+  rkey,
+  text,
+  created_at
+from
+  bluesky_car_post
+where
+  repo = 'bsky.app';
\ No newline at end of file
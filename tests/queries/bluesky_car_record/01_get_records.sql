@@ -0,0 +1,11 @@
+-- Test: Get records in a CAR repository export
+select
+  collection,
+  rkey,
+  cid,
+  type,
+  created_at
+from
+  bluesky_car_record
+where
+  path = '/tmp/bsky.app.car';
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- Test: Get all direct message conversations of the authenticated user
+select
+  id,
+  status,
+  member_handles,
+  unread_count,
+  last_message_text
+from
+  bluesky_chat_convo;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get the messages of the first conversation of the authenticated user
+select
+  m.id,
+  m.sender_handle,
+  m.text,
+  m.sent_at
+from
+  bluesky_chat_message m
+where
+  m.convo_id = (select id from bluesky_chat_convo limit 1);
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- Test: Get posts from a feed by at:// URI
+select
+  uri,
+  text,
+  author,
+  created_at,
+  like_count,
+  repost_count
+from
+  bluesky_feed
+where
+  feed_uri = 'at://did:plc:z72i7hdynmk6r22z27h6tvur/app.bsky.feed.generator/whats-hot'
+limit 20;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Test: Get posts from a feed by bsky.app URL
+select
+  uri,
+  text,
+  author,
+  created_at
+from
+  bluesky_feed
+where
+  feed_uri = 'https://bsky.app/profile/bsky.app/feed/whats-hot'
+limit 20;
\ No newline at end of file
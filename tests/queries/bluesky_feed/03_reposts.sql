@@ -0,0 +1,14 @@
+-- Test: Get reposted items with feed context
+select
+  uri,
+  author,
+  reason_type,
+  reposted_by,
+  reposted_at,
+  feed_context
+from
+  bluesky_feed
+where
+  feed_uri = 'at://did:plc:z72i7hdynmk6r22z27h6tvur/app.bsky.feed.generator/whats-hot'
+  and reason_type = 'repost'
+limit 20;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- Test: Get a feed generator by URI
+select
+  uri,
+  display_name,
+  creator_handle,
+  like_count,
+  is_online,
+  is_valid
+from
+  bluesky_feed_generator
+where
+  uri = 'at://did:plc:z72i7hdynmk6r22z27h6tvur/app.bsky.feed.generator/whats-hot';
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get a feed generator by bsky.app URL
+select
+  uri,
+  http_url,
+  display_name,
+  service_did
+from
+  bluesky_feed_generator
+where
+  http_url = 'https://bsky.app/profile/bsky.app/feed/whats-hot';
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- Test: Get all feeds published by a user
+select
+  uri,
+  display_name,
+  like_count,
+  accepts_interactions,
+  content_mode,
+  indexed_at
+from
+  bluesky_feed_generator
+where
+  creator_did = 'did:plc:z72i7hdynmk6r22z27h6tvur';
\ No newline at end of file
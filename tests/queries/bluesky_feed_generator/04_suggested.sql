@@ -0,0 +1,9 @@
+-- Test: Get suggested feeds
+select
+  uri,
+  display_name,
+  creator_handle,
+  like_count
+from
+  bluesky_feed_generator
+limit 20;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Test: Sample operations from the firehose
+select
+  seq,
+  did,
+  action,
+  collection,
+  rkey
+from
+  bluesky_firehose
+where
+  "limit" = 10;
\ No newline at end of file
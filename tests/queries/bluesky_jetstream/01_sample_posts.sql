@@ -0,0 +1,11 @@
+-- Test: Sample post events from Jetstream
+select
+  kind,
+  did,
+  operation,
+  uri
+from
+  bluesky_jetstream
+where
+  collection = 'app.bsky.feed.post'
+  and "limit" = 10;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Test: Sample feed events from Jetstream with a collection wildcard
+select
+  kind,
+  collection,
+  operation,
+  uri
+from
+  bluesky_jetstream
+where
+  collections = '["app.bsky.feed.*"]'
+  and "limit" = 10;
\ No newline at end of file
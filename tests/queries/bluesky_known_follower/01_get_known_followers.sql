@@ -0,0 +1,9 @@
+-- Test: Get followers of a user that the authenticated user also follows
+select
+  did,
+  handle,
+  display_name
+from
+  bluesky_known_follower
+where
+  target_did = 'did:plc:z72i7hdynmk6r22z27h6tvur';
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- Test: Get labels applied by a labeler to an account's records
+select
+  src,
+  uri,
+  val,
+  neg,
+  cts
+from
+  bluesky_label
+where
+  uri_patterns = 'at://did:plc:vipregezugaizr3kfcjijzrv/*'
+  and sources = 'did:plc:ar7c4by46qjdydhdevvrndac';
\ No newline at end of file
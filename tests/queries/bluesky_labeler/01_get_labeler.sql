@@ -0,0 +1,12 @@
+-- Test: Get the service definition of a labeler
+select
+  did,
+  creator_handle,
+  like_count,
+  label_values,
+  label_value_definitions,
+  reason_types
+from
+  bluesky_labeler
+where
+  did = 'did:plc:ar7c4by46qjdydhdevvrndac';
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Test: Get all lists created by a user
+select
+  uri,
+  name,
+  purpose,
+  list_item_count,
+  indexed_at
+from
+  bluesky_list
+where
+  creator_did = 'did:plc:vipregezugaizr3kfcjijzrv';
\ No newline at end of file
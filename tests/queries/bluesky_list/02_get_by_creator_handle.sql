@@ -0,0 +1,11 @@
+-- Test: Get all lists created by a user by handle
+select
+  uri,
+  name,
+  purpose,
+  description,
+  list_item_count
+from
+  bluesky_list
+where
+  creator_handle = 'matty.wtf';
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get moderation lists created by a user
+select
+  uri,
+  name,
+  list_item_count
+from
+  bluesky_list
+where
+  creator_did = 'did:plc:vipregezugaizr3kfcjijzrv'
+  and purpose = 'app.bsky.graph.defs#modlist';
\ No newline at end of file
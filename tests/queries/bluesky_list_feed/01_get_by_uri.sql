@@ -0,0 +1,13 @@
+-- Test: Get posts from list members by list URI
+select
+  uri,
+  text,
+  author,
+  created_at,
+  like_count,
+  repost_count
+from
+  bluesky_list_feed
+where
+  list_uri = 'at://did:plc:vipregezugaizr3kfcjijzrv/app.bsky.graph.list/3kfkrlpz6yl2e'
+limit 20;
\ No newline at end of file
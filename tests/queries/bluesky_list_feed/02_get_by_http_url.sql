@@ -0,0 +1,11 @@
+-- Test: Get posts from list members by bsky.app URL
+select
+  uri,
+  text,
+  author,
+  created_at
+from
+  bluesky_list_feed
+where
+  list_uri = 'https://bsky.app/profile/matty.wtf/lists/3kfkrlpz6yl2e'
+limit 20;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Test: Get posts with hashtags from list members
+select
+  uri,
+  author,
+  hashtags
+from
+  bluesky_list_feed
+where
+  list_uri = 'at://did:plc:vipregezugaizr3kfcjijzrv/app.bsky.graph.list/3kfkrlpz6yl2e'
+  and hashtags is not null
+limit 20;
\ No newline at end of file
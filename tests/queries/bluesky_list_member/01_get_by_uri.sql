@@ -0,0 +1,11 @@
+-- Test: Get all members of a list by URI
+select
+  did,
+  handle,
+  display_name,
+  follower_count,
+  list_item_uri
+from
+  bluesky_list_member
+where
+  list_uri = 'at://did:plc:vipregezugaizr3kfcjijzrv/app.bsky.graph.list/3kfkrlpz6yl2e';
\ No newline at end of file
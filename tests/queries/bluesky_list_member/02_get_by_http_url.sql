@@ -0,0 +1,9 @@
+-- Test: Get all members of a list by bsky.app URL
+select
+  did,
+  handle,
+  display_name
+from
+  bluesky_list_member
+where
+  list_uri = 'https://bsky.app/profile/matty.wtf/lists/3kfkrlpz6yl2e';
\ No newline at end of file
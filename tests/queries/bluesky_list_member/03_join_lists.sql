@@ -0,0 +1,10 @@
+-- Test: Get the members of every list created by a user
+select
+  l.name,
+  m.handle,
+  m.display_name
+from
+  bluesky_list l
+  join bluesky_list_member m on m.list_uri = l.uri
+where
+  l.creator_did = 'did:plc:vipregezugaizr3kfcjijzrv';
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Test: Get all users blocked by the authenticated user
+select
+  did,
+  handle,
+  display_name,
+  block_uri
+from
+  bluesky_my_block;
\ No newline at end of file
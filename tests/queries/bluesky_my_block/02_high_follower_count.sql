@@ -0,0 +1,9 @@
+-- Test: Get blocked users with a large following
+select
+  handle,
+  display_name,
+  follower_count
+from
+  bluesky_my_block
+where
+  follower_count > 1000;
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- Test: Get all moderation list subscriptions
+select
+  uri,
+  name,
+  creator_handle,
+  subscription,
+  list_item_count
+from
+  bluesky_my_list_subscription;
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- Test: Get moderation lists subscribed to for blocking
+select
+  uri,
+  name,
+  list_item_count
+from
+  bluesky_my_list_subscription
+where
+  subscription = 'block';
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Test: Get all users muted by the authenticated user
+select
+  did,
+  handle,
+  display_name,
+  description
+from
+  bluesky_my_mute;
\ No newline at end of file
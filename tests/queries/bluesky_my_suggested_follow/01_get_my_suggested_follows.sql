@@ -0,0 +1,8 @@
+-- Test: Get accounts suggested for the authenticated user
+select
+  did,
+  handle,
+  display_name
+from
+  bluesky_my_suggested_follow
+limit 10;
\ No newline at end of file
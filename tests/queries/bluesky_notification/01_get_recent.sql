@@ -0,0 +1,10 @@
+-- Test: Get recent notifications
+select
+  uri,
+  reason,
+  author_handle,
+  is_read,
+  indexed_at
+from
+  bluesky_notification
+limit 20;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- Test: Get reply and mention notifications
+select
+  uri,
+  reason,
+  author_handle,
+  text,
+  indexed_at
+from
+  bluesky_notification
+where
+  reason in ('reply', 'mention')
+limit 20;
\ No newline at end of file
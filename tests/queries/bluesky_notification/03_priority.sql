@@ -0,0 +1,11 @@
+-- Test: Get priority notifications
+select
+  uri,
+  reason,
+  author_handle,
+  record
+from
+  bluesky_notification
+where
+  priority = true
+limit 20;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- Test: Get the unread notification count
+select
+  count,
+  priority
+from
+  bluesky_notification_unread_count;
\ No newline at end of file
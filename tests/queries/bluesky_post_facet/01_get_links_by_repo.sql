@@ -0,0 +1,11 @@
+-- Test: Get links and their anchor text in a repository
+select
+  post_uri,
+  value,
+  text,
+  is_valid
+from
+  bluesky_post_facet
+where
+  repo = 'bsky.app'
+  and feature_type = 'link';
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get media in posts of a repository
+select
+  post_uri,
+  media_type,
+  alt,
+  has_alt
+from
+  bluesky_post_media
+where
+  repo = 'bsky.app';
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- Test: Get all preferences of the authenticated user
+select
+  type,
+  key,
+  value
+from
+  bluesky_preference;
\ No newline at end of file
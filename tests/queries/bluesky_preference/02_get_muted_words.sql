@@ -0,0 +1,10 @@
+-- Test: Get muted words of the authenticated user
+select
+  key,
+  targets,
+  actor_target,
+  expires_at
+from
+  bluesky_preference
+where
+  type = 'mutedWordsPref';
\ No newline at end of file
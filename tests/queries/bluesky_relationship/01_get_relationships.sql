@@ -0,0 +1,13 @@
+-- Test: Get relationships between a user and several others
+select
+  other,
+  other_did,
+  following,
+  followed_by,
+  is_mutual,
+  not_found
+from
+  bluesky_relationship
+where
+  actor = 'bsky.app'
+  and others = '["jay.bsky.team", "pfrazee.com"]';
\ No newline at end of file
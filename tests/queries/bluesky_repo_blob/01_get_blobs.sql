@@ -0,0 +1,8 @@
+-- Test: Get blobs in a repository
+select
+  cid,
+  did
+from
+  bluesky_repo_blob
+where
+  repo = 'bsky.app';
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Test: Get blobs missing from the authenticated account's PDS
+select
+  cid,
+  record_uri
+from
+  bluesky_repo_blob
+where
+  missing = true;
\ No newline at end of file
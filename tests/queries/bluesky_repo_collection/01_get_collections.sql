@@ -0,0 +1,10 @@
+-- Test: Get the collections in a repository
+select
+  collection,
+  did,
+  handle,
+  handle_is_correct
+from
+  bluesky_repo_collection
+where
+  repo = 'bsky.app';
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Test: Get the records of a collection in a repository
+select
+  uri,
+  cid,
+  rkey,
+  type,
+  created_at,
+  value
+from
+  bluesky_repo_record
+where
+  repo = 'bsky.app'
+  and collection = 'app.bsky.feed.post'
+limit 10;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Test: Get all starter packs created by a user
+select
+  uri,
+  name,
+  list_item_count,
+  joined_week_count,
+  joined_all_time_count
+from
+  bluesky_starter_pack
+where
+  creator_did = 'did:plc:vipregezugaizr3kfcjijzrv';
\ No newline at end of file
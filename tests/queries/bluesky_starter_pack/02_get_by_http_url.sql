@@ -0,0 +1,12 @@
+-- Test: Get a starter pack by bsky.app URL
+select
+  uri,
+  name,
+  description,
+  creator_handle,
+  list_uri,
+  feeds
+from
+  bluesky_starter_pack
+where
+  http_url = 'https://bsky.app/starter-pack/matty.wtf/3l6y7kmgo7r2s';
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Test: Search for starter packs by keyword
+select
+  uri,
+  name,
+  creator_handle,
+  joined_all_time_count
+from
+  bluesky_starter_pack
+where
+  query = 'steampipe'
+limit 20;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get all members of a starter pack by URI
+select
+  did,
+  handle,
+  display_name,
+  follower_count
+from
+  bluesky_starter_pack_member
+where
+  starter_pack_uri = 'at://did:plc:vipregezugaizr3kfcjijzrv/app.bsky.graph.starterpack/3l6y7kmgo7r2s';
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get all members of a starter pack by bsky.app URL
+select
+  did,
+  handle,
+  list_uri,
+  list_item_uri
+from
+  bluesky_starter_pack_member
+where
+  starter_pack_uri = 'https://bsky.app/starter-pack/matty.wtf/3l6y7kmgo7r2s';
\ No newline at end of file
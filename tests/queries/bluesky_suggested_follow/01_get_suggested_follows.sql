@@ -0,0 +1,10 @@
+-- Test: Get accounts suggested based on a specific user
+select
+  did,
+  handle,
+  display_name,
+  is_fallback
+from
+  bluesky_suggested_follow
+where
+  target_did = 'did:plc:z72i7hdynmk6r22z27h6tvur';
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get all likes by handle
+select
+  uri,
+  subject_uri,
+  created_at
+from
+  bluesky_user_like
+where
+  handle = 'matty.wtf'
+limit 20;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get posts with images missing alt text
+select
+  uri,
+  embed_type,
+  images_missing_alt_count
+from
+  bluesky_user_post
+where
+  target_did = 'did:plc:vipregezugaizr3kfcjijzrv'
+  and images_missing_alt_count > 0;
\ No newline at end of file
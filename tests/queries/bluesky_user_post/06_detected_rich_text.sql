@@ -0,0 +1,12 @@
+-- Test: Get posts whose facets miss mentions, links or tags in the text
+select
+  uri,
+  hashtags,
+  detected_hashtags,
+  detected_links,
+  detected_mentions
+from
+  bluesky_user_post
+where
+  target_did = 'did:plc:vipregezugaizr3kfcjijzrv'
+  and detected_hashtags is not null;
\ No newline at end of file
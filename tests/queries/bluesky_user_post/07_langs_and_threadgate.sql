@@ -0,0 +1,14 @@
+-- Test: Get post languages, tags and reply restrictions
+select
+  uri,
+  langs,
+  self_labels,
+  tags,
+  threadgate_rules,
+  hidden_replies,
+  reply_disabled,
+  embedding_disabled
+from
+  bluesky_user_post
+where
+  target_did = 'did:plc:vipregezugaizr3kfcjijzrv';
\ No newline at end of file
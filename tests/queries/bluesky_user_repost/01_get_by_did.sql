@@ -0,0 +1,12 @@
+-- Test: Get all reposts by DID
+select
+  uri,
+  rkey,
+  subject_uri,
+  subject_cid,
+  created_at
+from
+  bluesky_user_repost
+where
+  target_did = 'did:plc:vipregezugaizr3kfcjijzrv'
+limit 20;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Test: Get all reposts by handle
+select
+  uri,
+  subject_uri,
+  created_at
+from
+  bluesky_user_repost
+where
+  handle = 'matty.wtf'
+limit 20;
\ No newline at end of file
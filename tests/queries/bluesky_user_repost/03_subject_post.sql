@@ -0,0 +1,11 @@
+-- Test: Get reposts with the subject post
+select
+  subject_uri,
+  subject_author,
+  subject_text,
+  subject_like_count
+from
+  bluesky_user_repost
+where
+  target_did = 'did:plc:vipregezugaizr3kfcjijzrv'
+limit 5;
\ No newline at end of file